@@ -0,0 +1,168 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package export
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+)
+
+// timestampType is the Arrow type of timestamp columns.
+var timestampType = &arrow.TimestampType{Unit: arrow.Millisecond, TimeZone: "UTC"}
+
+// arrowType returns the Arrow type of the given column. Untyped API
+// values are written as strings.
+func arrowType(c column) (arrow.DataType, error) {
+	if c.timestamp {
+		return timestampType, nil
+	}
+
+	switch c.typ.Kind() {
+	case reflect.String, reflect.Interface:
+		return arrow.BinaryTypes.String, nil
+	case reflect.Bool:
+		return arrow.FixedWidthTypes.Boolean, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return arrow.PrimitiveTypes.Int64, nil
+	case reflect.Float32, reflect.Float64:
+		return arrow.PrimitiveTypes.Float64, nil
+	}
+
+	return nil, errors.New("invalid column type: " + c.name + " " + c.typ.String())
+}
+
+// arrowSchema returns the Arrow schema of the given columns. Every
+// column is nullable since nested pointers may be nil.
+func arrowSchema(cols []column) (*arrow.Schema, error) {
+	fields := make([]arrow.Field, 0, len(cols))
+	for _, c := range cols {
+		typ, err := arrowType(c)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, arrow.Field{Name: c.name, Type: typ, Nullable: true})
+	}
+
+	return arrow.NewSchema(fields, nil), nil
+}
+
+// record converts the rows of the given value into an Arrow record.
+// The caller must release the record.
+func record(v any) (arrow.Record, error) {
+	rows, err := records(v)
+	if err != nil {
+		return nil, err
+	}
+
+	cols := columns(rowType(rows.Type().Elem()))
+	schema, err := arrowSchema(cols)
+	if err != nil {
+		return nil, err
+	}
+
+	b := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer b.Release()
+
+	for i := 0; i < rows.Len(); i++ {
+		row := rows.Index(i)
+		for j, c := range cols {
+			appendValue(b.Field(j), c, row)
+		}
+	}
+
+	return b.NewRecord(), nil
+}
+
+// appendValue appends the value of the column in the given row to the
+// builder or a null if it has no value.
+func appendValue(b array.Builder, c column, row reflect.Value) {
+	v, ok := c.value(row)
+	if ok && v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			ok = false
+		} else {
+			v = v.Elem()
+		}
+	}
+	if !ok {
+		b.AppendNull()
+		return
+	}
+
+	switch b := b.(type) {
+	case *array.TimestampBuilder:
+		t, ok := c.time(v)
+		if !ok {
+			b.AppendNull()
+			return
+		}
+		b.Append(arrow.Timestamp(t.UnixMilli()))
+	case *array.StringBuilder:
+		if v.Kind() == reflect.String {
+			b.Append(v.String())
+			return
+		}
+		b.Append(fmt.Sprint(v.Interface()))
+	case *array.BooleanBuilder:
+		b.Append(v.Bool())
+	case *array.Int64Builder:
+		if v.CanUint() {
+			b.Append(int64(v.Uint()))
+			return
+		}
+		b.Append(v.Int())
+	case *array.Float64Builder:
+		b.Append(v.Float())
+	}
+}
+
+// WriteArrow writes the rows of the given value, which may be a
+// response, a slice of structs or a single struct, to the writer as an
+// Arrow IPC stream.
+func WriteArrow(w io.Writer, v any) error {
+	rec, err := record(v)
+	if err != nil {
+		return err
+	}
+	defer rec.Release()
+
+	iw := ipc.NewWriter(w, ipc.WithSchema(rec.Schema()))
+	if err := iw.Write(rec); err != nil {
+		iw.Close()
+		return err
+	}
+
+	return iw.Close()
+}
@@ -0,0 +1,239 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+// package export writes PLL API responses and the structures derived
+// from them to flat files for use in spreadsheets and data science
+// tools. Nested structs such as a standing's team or a player's stat
+// line are flattened into columns named by joining their JSON names
+// with a dot, e.g. "team.fullName" or "stats.points". Embedded structs
+// are flattened without a prefix. Slice and map fields are skipped.
+//
+// time.Time and pll.UnixTime fields are written as timestamps. Zero
+// values are written as empty or null.
+package export
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/briandowns/pll/pll"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	unixTimeType = reflect.TypeOf(pll.UnixTime(0))
+)
+
+// column is a single flattened field of a struct.
+type column struct {
+	name      string
+	index     []int
+	typ       reflect.Type
+	timestamp bool
+}
+
+// columns returns the flattened columns of the given struct type in
+// field order.
+func columns(t reflect.Type) []column {
+	var cols []column
+	flatten(t, "", nil, &cols)
+	return cols
+}
+
+// flatten appends the columns of the given struct type to cols.
+func flatten(t reflect.Type, prefix string, index []int, cols *[]column) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		idx := append(append([]int{}, index...), i)
+
+		ft := f.Type
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+
+		switch ft.Kind() {
+		case reflect.Struct:
+			if ft == timeType {
+				break
+			}
+			if f.Anonymous && name == "" {
+				flatten(ft, prefix, idx, cols)
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+			flatten(ft, prefix+name+".", idx, cols)
+			continue
+		case reflect.Slice, reflect.Array, reflect.Map, reflect.Func, reflect.Chan:
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+		*cols = append(*cols, column{
+			name:      prefix + name,
+			index:     idx,
+			typ:       ft,
+			timestamp: ft == timeType || ft == unixTimeType,
+		})
+	}
+}
+
+// value returns the value of the column in the given struct or false if
+// a pointer along the way is nil.
+func (c column) value(v reflect.Value) (reflect.Value, bool) {
+	for _, i := range c.index {
+		if v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+		v = v.Elem()
+	}
+
+	return v, true
+}
+
+// time returns the value of a timestamp column or false if it is zero.
+func (c column) time(v reflect.Value) (time.Time, bool) {
+	if v.Type() == timeType {
+		t := v.Interface().(time.Time)
+		return t, !t.IsZero()
+	}
+
+	t := pll.UnixTime(v.Int())
+	if t == 0 {
+		return time.Time{}, false
+	}
+
+	return t.Time(), true
+}
+
+// selectColumns returns the given columns in the order of names or all
+// of them if names is empty.
+func selectColumns(cols []column, names []string) ([]column, error) {
+	if len(names) == 0 {
+		return cols, nil
+	}
+
+	byName := make(map[string]column, len(cols))
+	for _, c := range cols {
+		byName[c.name] = c
+	}
+
+	selected := make([]column, 0, len(names))
+	for _, name := range names {
+		c, ok := byName[name]
+		if !ok {
+			return nil, errors.New("invalid column: " + name)
+		}
+		selected = append(selected, c)
+	}
+
+	return selected, nil
+}
+
+// records returns the rows contained in the given value. A slice of
+// structs is returned as is, a response holding a single slice of
+// structs, directly or within a nested struct, returns that slice and
+// any other struct is treated as a single row.
+func records(v any) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return reflect.Value{}, errors.New("invalid value: nil")
+		}
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+	case reflect.Struct:
+		if s, ok := responseSlice(rv); ok {
+			rv = s
+			break
+		}
+		s := reflect.MakeSlice(reflect.SliceOf(rv.Type()), 1, 1)
+		s.Index(0).Set(rv)
+		rv = s
+	default:
+		return reflect.Value{}, errors.New("invalid value: " + rv.Type().String())
+	}
+
+	if rowType(rv.Type().Elem()).Kind() != reflect.Struct {
+		return reflect.Value{}, errors.New("invalid value: " + rv.Type().String())
+	}
+
+	return rv, nil
+}
+
+// responseSlice returns the slice held by a struct with a single field,
+// following nested single field structs.
+func responseSlice(v reflect.Value) (reflect.Value, bool) {
+	if v.NumField() != 1 || !v.Type().Field(0).IsExported() {
+		return reflect.Value{}, false
+	}
+
+	f := v.Field(0)
+	switch f.Kind() {
+	case reflect.Slice:
+		return f, rowType(f.Type().Elem()).Kind() == reflect.Struct
+	case reflect.Struct:
+		return responseSlice(f)
+	}
+
+	return reflect.Value{}, false
+}
+
+// rowType returns the struct type of a row, dereferencing pointers.
+func rowType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t
+}
@@ -0,0 +1,181 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package export
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// Encoder writes rows as comma or tab separated values. Each call to
+// Encode writes and flushes its rows so large results can be streamed
+// in batches.
+type Encoder struct {
+	// Header controls whether a header row is written before the
+	// first row.
+	Header bool
+
+	// Headers maps column names to the header written for them.
+	// Columns not in the map use their column name.
+	Headers map[string]string
+
+	// Columns limits the output to the named columns in the given
+	// order. All columns are written when empty.
+	Columns []string
+
+	w     *csv.Writer
+	typ   reflect.Type
+	cols  []column
+	wrote bool
+}
+
+// NewCSVEncoder creates a new encoder writing comma separated values to
+// the given writer.
+func NewCSVEncoder(w io.Writer) *Encoder {
+	return &Encoder{
+		Header: true,
+		w:      csv.NewWriter(w),
+	}
+}
+
+// NewTSVEncoder creates a new encoder writing tab separated values to
+// the given writer.
+func NewTSVEncoder(w io.Writer) *Encoder {
+	e := NewCSVEncoder(w)
+	e.w.Comma = '\t'
+
+	return e
+}
+
+// Encode writes the rows of the given value, which may be a response,
+// a slice of structs or a single struct. Every call must be given rows
+// of the same type.
+func (e *Encoder) Encode(v any) error {
+	rows, err := records(v)
+	if err != nil {
+		return err
+	}
+
+	typ := rowType(rows.Type().Elem())
+	if e.typ == nil {
+		cols, err := selectColumns(columns(typ), e.Columns)
+		if err != nil {
+			return err
+		}
+		e.typ = typ
+		e.cols = cols
+	}
+	if typ != e.typ {
+		return errors.New("invalid row type: " + typ.String())
+	}
+
+	if e.Header && !e.wrote {
+		header := make([]string, len(e.cols))
+		for i, c := range e.cols {
+			header[i] = c.name
+			if h, ok := e.Headers[c.name]; ok {
+				header[i] = h
+			}
+		}
+		if err := e.w.Write(header); err != nil {
+			return err
+		}
+	}
+	e.wrote = true
+
+	record := make([]string, len(e.cols))
+	for i := 0; i < rows.Len(); i++ {
+		row := rows.Index(i)
+		for j, c := range e.cols {
+			record[j] = ""
+			v, ok := c.value(row)
+			switch {
+			case !ok:
+			case c.timestamp:
+				if t, ok := c.time(v); ok {
+					record[j] = t.UTC().Format(time.RFC3339)
+				}
+			default:
+				record[j] = format(v)
+			}
+		}
+		if err := e.w.Write(record); err != nil {
+			return err
+		}
+	}
+
+	e.w.Flush()
+
+	return e.w.Error()
+}
+
+// ColumnNames returns the names of the columns the given value is
+// flattened into.
+func ColumnNames(v any) ([]string, error) {
+	rows, err := records(v)
+	if err != nil {
+		return nil, err
+	}
+
+	cols := columns(rowType(rows.Type().Elem()))
+	names := make([]string, len(cols))
+	for i, c := range cols {
+		names[i] = c.name
+	}
+
+	return names, nil
+}
+
+// format returns the given value as a string.
+func format(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 32)
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	case reflect.Interface:
+		if v.IsNil() {
+			return ""
+		}
+		return fmt.Sprint(v.Interface())
+	}
+
+	return fmt.Sprint(v.Interface())
+}
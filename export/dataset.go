@@ -0,0 +1,228 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package export
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/briandowns/pll/pll"
+)
+
+// Format is the file format a dataset is written in.
+type Format int
+
+const (
+	Parquet Format = iota
+	Arrow
+)
+
+// ext returns the file extension of the format.
+func (f Format) ext() string {
+	if f == Arrow {
+		return ".arrows"
+	}
+
+	return ".parquet"
+}
+
+// write writes the rows of the given value in the format.
+func (f Format) write(w io.Writer, v any) error {
+	if f == Arrow {
+		return WriteArrow(w, v)
+	}
+
+	return WriteParquet(w, v)
+}
+
+// Partition identifies the season and segment of a set of rows.
+type Partition struct {
+	Year    int
+	Segment string
+}
+
+// String returns the partition's path relative to its table.
+func (p Partition) String() string {
+	return fmt.Sprintf("season=%d/segment=%s", p.Year, p.Segment)
+}
+
+// Dataset writes tables under a directory partitioned by season and
+// segment using Hive style paths, e.g.
+// standings/season=2024/segment=regular/standings.parquet, which
+// pandas, polars and DuckDB read as partition columns.
+type Dataset struct {
+	Dir    string
+	Format Format
+}
+
+// NewDataset creates a new dataset rooted at the given directory.
+func NewDataset(dir string, format Format) *Dataset {
+	return &Dataset{
+		Dir:    dir,
+		Format: format,
+	}
+}
+
+// Write writes the rows of the given value to a file with the given
+// name in the table's partition and returns its path.
+func (d *Dataset) Write(table, name string, p Partition, v any) (string, error) {
+	if err := pll.ValidSeasonSegment(p.Segment); err != nil {
+		return "", err
+	}
+
+	dir := filepath.Join(d.Dir, table, filepath.FromSlash(p.String()))
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, name+d.Format.ext())
+	f, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+
+	if err := d.Format.write(f, v); err != nil {
+		f.Close()
+		return "", err
+	}
+
+	return path, f.Close()
+}
+
+// WriteStandings writes the standings of the given year and segment.
+func (d *Dataset) WriteStandings(year int, segment string, res *pll.StandingsResponse) error {
+	_, err := d.Write("standings", "standings", Partition{Year: year, Segment: segment}, res.Standings)
+	return err
+}
+
+// leader is a player stat leader with a numeric stat value.
+type leader struct {
+	OfficialID string   `json:"officialId"`
+	FirstName  string   `json:"firstName"`
+	LastName   string   `json:"lastName"`
+	Position   string   `json:"position"`
+	JerseyNum  string   `json:"jerseyNum"`
+	TeamID     string   `json:"teamId"`
+	Year       int      `json:"year"`
+	StatType   string   `json:"statType"`
+	StatValue  *float64 `json:"statValue"`
+	PlayerRank int      `json:"playerRank"`
+}
+
+// WriteLeaders writes the player stat leaders of the given year and
+// segment. Stat values are written as floats, or null if they can't
+// be parsed.
+func (d *Dataset) WriteLeaders(year int, segment string, res *pll.PlayerStatsResponse) error {
+	leaders := make([]leader, 0, len(res.PlayerStatLeaders))
+	for _, l := range res.PlayerStatLeaders {
+		row := leader{
+			OfficialID: l.OfficialID,
+			FirstName:  l.FirstName,
+			LastName:   l.LastName,
+			Position:   l.Position,
+			JerseyNum:  l.JerseyNum,
+			TeamID:     l.TeamID,
+			Year:       l.Year,
+			StatType:   l.StatType,
+			PlayerRank: l.PlayerRank,
+		}
+		if v, err := (pll.PlayerStatValue{Value: l.StatValue}).Float(); err == nil {
+			row.StatValue = &v
+		}
+		leaders = append(leaders, row)
+	}
+
+	_, err := d.Write("leaders", "leaders", Partition{Year: year, Segment: segment}, leaders)
+	return err
+}
+
+// WriteTeamSeasons writes the given team stat lines, partitioned by
+// their year and segment.
+func (d *Dataset) WriteTeamSeasons(seasons []pll.TeamSeason) error {
+	parts := partition(seasons, func(ts pll.TeamSeason) Partition {
+		return Partition{Year: ts.Year, Segment: ts.SeasonSegment}
+	})
+
+	for _, p := range parts {
+		if _, err := d.Write("team_stats", "team_stats", p.Partition, p.rows); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteGameLogs writes the given player's game logs, partitioned by
+// the year and segment of each game, to files named by the player's
+// ID.
+func (d *Dataset) WriteGameLogs(playerID string, logs []pll.PlayerGameLog) error {
+	parts := partition(logs, func(l pll.PlayerGameLog) Partition {
+		return Partition{Year: l.Game.Year, Segment: l.Game.SeasonSegment}
+	})
+
+	for _, p := range parts {
+		if _, err := d.Write("game_logs", playerID, p.Partition, p.rows); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// partitionRows contains the rows of a single partition.
+type partitionRows[T any] struct {
+	Partition
+	rows []T
+}
+
+// partition groups the given rows by their partition, ordered by year
+// and segment.
+func partition[T any](rows []T, key func(T) Partition) []partitionRows[T] {
+	byPartition := make(map[Partition][]T)
+	for _, r := range rows {
+		p := key(r)
+		byPartition[p] = append(byPartition[p], r)
+	}
+
+	parts := make([]partitionRows[T], 0, len(byPartition))
+	for p, rows := range byPartition {
+		parts = append(parts, partitionRows[T]{Partition: p, rows: rows})
+	}
+
+	slices.SortFunc(parts, func(a, b partitionRows[T]) int {
+		if c := cmp.Compare(a.Year, b.Year); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Segment, b.Segment)
+	})
+
+	return parts
+}
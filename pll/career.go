@@ -0,0 +1,239 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package pll
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+)
+
+// countingFloats contains the float stats that are counts rather than
+// rates and are summed across seasons.
+var countingFloats = []string{
+	"tof",
+	"pim",
+}
+
+// PlayerCareer is a player along with their stats accumulated across
+// multiple seasons.
+type PlayerCareer struct {
+	Player
+	FromYear int         `json:"fromYear"`
+	ToYear   int         `json:"toYear"`
+	Seasons  int         `json:"seasons"`
+	Stats    PlayerStats `json:"stats"`
+}
+
+// Careers accumulates the given seasons into one career per player in
+// the order each player first appears. Counting stats are summed and
+// rate stats are recomputed from the summed counts rather than
+// averaged. The player details of the most recent season are used.
+func Careers(seasons []PlayerSeason) []PlayerCareer {
+	var careers []PlayerCareer
+	idx := make(map[string]int)
+	years := make(map[string][]int)
+
+	for _, ps := range seasons {
+		i, ok := idx[ps.OfficialID]
+		if !ok {
+			i = len(careers)
+			idx[ps.OfficialID] = i
+			careers = append(careers, PlayerCareer{
+				Player:   ps.Player,
+				FromYear: ps.Year,
+				ToYear:   ps.Year,
+			})
+		}
+
+		c := &careers[i]
+		if ps.Year >= c.ToYear {
+			c.Player = ps.Player
+			c.ToYear = ps.Year
+		}
+		c.FromYear = min(c.FromYear, ps.Year)
+
+		if !slices.Contains(years[ps.OfficialID], ps.Year) {
+			years[ps.OfficialID] = append(years[ps.OfficialID], ps.Year)
+			c.Seasons++
+		}
+
+		// goalie seasons without time on field count a regulation game
+		// per game played, as NewGoalieStats does, so mixing them with
+		// seasons that report it doesn't skew career averages
+		stats := ps.Stats
+		if stats.Saves+stats.GoalsAgainst > 0 {
+			stats.Tof = minutesPlayed(stats)
+		}
+		addCounts(&c.Stats, stats)
+	}
+
+	for i := range careers {
+		careers[i].Stats.calculateRates()
+	}
+
+	return careers
+}
+
+// addCounts adds the counting stats of src to dst.
+func addCounts(dst *PlayerStats, src PlayerStats) {
+	dv := reflect.ValueOf(dst).Elem()
+	sv := reflect.ValueOf(src)
+
+	for i := 0; i < dv.NumField(); i++ {
+		f := dv.Field(i)
+		switch f.Kind() {
+		case reflect.Int:
+			f.SetInt(f.Int() + sv.Field(i).Int())
+		case reflect.Float64:
+			if slices.Contains(countingFloats, jsonName(dv.Type().Field(i))) {
+				f.SetFloat(f.Float() + sv.Field(i).Float())
+			}
+		}
+	}
+}
+
+// calculateRates computes the rate stats from the counting stats.
+func (s *PlayerStats) calculateRates() {
+	gp := float64(s.GamesPlayed)
+
+	s.ShotPct = ratio(s.Goals, float64(s.Shots))
+	s.TwoPointShotPct = ratio(s.TwoPointGoals, float64(s.TwoPointShots))
+	s.ShotsOnGoalPct = ratio(s.ShotsOnGoal, float64(s.Shots))
+	s.FaceoffPct = ratio(s.FaceoffsWon, float64(s.Faceoffs))
+	s.SavePct = ratio(s.Saves, float64(s.Saves+s.GoalsAgainst))
+	s.Saa = ratio(s.ScoresAgainst*regulationMinutes, minutesPlayed(*s))
+	s.PointsPG = ratio(s.Points, gp)
+	s.OnePointGoalsPG = ratio(s.OnePointGoals, gp)
+	s.AssistsPG = ratio(s.Assists, gp)
+	s.ShotsPG = ratio(s.Shots, gp)
+	s.TouchesPG = ratio(s.Touches, gp)
+	s.FaceoffWinsPG = ratio(s.FaceoffsWon, gp)
+	s.SavesPG = ratio(s.Saves, gp)
+	s.CausedTurnoversPG = ratio(s.CausedTurnovers, gp)
+	s.GroundBallsPG = ratio(s.GroundBalls, gp)
+}
+
+// CareerOptions
+type CareerOptions struct {
+	Qualifier
+	Segments []string // season segments included, defaults to every segment
+	Limit    int      // maximum number of leaders per stat, 0 for no limit
+}
+
+// CareerLeader is a player's career ranked by a single stat.
+type CareerLeader struct {
+	PlayerCareer
+	StatType   string  `json:"statType"`
+	StatValue  float64 `json:"statValue"`
+	PlayerRank int     `json:"playerRank"`
+}
+
+// CareerLeaders ranks the given careers by each of the given stats.
+// Leaders are grouped by stat in the order requested and ties share a
+// rank.
+func CareerLeaders(careers []PlayerCareer, stats []string, opts CareerOptions) ([]CareerLeader, error) {
+	for _, stat := range stats {
+		if _, ok := statValue(PlayerStats{}, stat); !ok {
+			return nil, errors.New("invalid stat: " + stat)
+		}
+	}
+
+	var leaders []CareerLeader
+	for _, stat := range stats {
+		var ranked []CareerLeader
+		for _, c := range careers {
+			if !opts.Qualifies(c.Stats, stat) {
+				continue
+			}
+			v, _ := statValue(c.Stats, stat)
+			ranked = append(ranked, CareerLeader{
+				PlayerCareer: c,
+				StatType:     stat,
+				StatValue:    v,
+			})
+		}
+
+		asc := slices.Contains(lowerIsBetter, stat)
+		slices.SortStableFunc(ranked, func(a, b CareerLeader) int {
+			if asc {
+				return cmp.Compare(a.StatValue, b.StatValue)
+			}
+			return cmp.Compare(b.StatValue, a.StatValue)
+		})
+
+		for i := range ranked {
+			if i > 0 && ranked[i].StatValue == ranked[i-1].StatValue {
+				ranked[i].PlayerRank = ranked[i-1].PlayerRank
+				continue
+			}
+			ranked[i].PlayerRank = i + 1
+		}
+
+		if opts.Limit > 0 && len(ranked) > opts.Limit {
+			ranked = ranked[:opts.Limit]
+		}
+
+		leaders = append(leaders, ranked...)
+	}
+
+	return leaders, nil
+}
+
+// CareerStats retrieves every player's stat line for each year in the
+// given range and season segment and accumulates them into careers.
+func (p *PLL) CareerStats(ctx context.Context, fromYear, toYear int, segments []string) ([]PlayerCareer, error) {
+	if segments == nil {
+		segments = seasonSegments
+	}
+
+	var seasons []PlayerSeason
+	for year := fromYear; year <= toYear; year++ {
+		for _, segment := range segments {
+			res, err := p.PlayerSeasonStats(ctx, year, segment)
+			if err != nil {
+				return nil, err
+			}
+			seasons = append(seasons, res.AllPlayers...)
+		}
+	}
+
+	return Careers(seasons), nil
+}
+
+// CareerLeaders retrieves the careers of every player across the given
+// range of years and ranks them by each of the given stats.
+func (p *PLL) CareerLeaders(ctx context.Context, fromYear, toYear int, stats []string, opts CareerOptions) ([]CareerLeader, error) {
+	careers, err := p.CareerStats(ctx, fromYear, toYear, opts.Segments)
+	if err != nil {
+		return nil, err
+	}
+
+	return CareerLeaders(careers, stats, opts)
+}
@@ -0,0 +1,73 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package pll
+
+import "testing"
+
+func TestCareerLeadersGoalieStats(t *testing.T) {
+	seasons := []PlayerSeason{
+		{
+			Player: Player{OfficialID: "skater"},
+			Year:   2023,
+			Stats:  PlayerStats{GamesPlayed: 10, Goals: 20, Shots: 60},
+		},
+		{
+			Player: Player{OfficialID: "goalie"},
+			Year:   2023,
+			Stats:  PlayerStats{GamesPlayed: 10, Saves: 120, GoalsAgainst: 100, ScoresAgainst: 110},
+		},
+		{
+			Player: Player{OfficialID: "goalie"},
+			Year:   2024,
+			Stats:  PlayerStats{GamesPlayed: 2, Saves: 20, GoalsAgainst: 30, ScoresAgainst: 34, Tof: 96},
+		},
+	}
+
+	careers := Careers(seasons)
+	leaders, err := CareerLeaders(careers, []string{"saa", "goalsAgainst"}, CareerOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(leaders) != 2 {
+		t.Fatalf("got %d leaders, want 2: %+v", len(leaders), leaders)
+	}
+	for _, l := range leaders {
+		if l.OfficialID != "goalie" {
+			t.Errorf("%s led by %s", l.StatType, l.OfficialID)
+		}
+	}
+
+	// the 2023 season has no time on field, so 10 regulation games are
+	// assumed: 144 scores against over 480 + 96 minutes
+	if got := leaders[0].StatValue; got != 12 {
+		t.Errorf("career saa = %v, want 12", got)
+	}
+	if got := NewGoalieStats(careers[1].Stats).ScoresAgainstAverage; got != 12 {
+		t.Errorf("goalie saa = %v, want 12", got)
+	}
+}
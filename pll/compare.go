@@ -0,0 +1,181 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package pll
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+)
+
+// ComparisonOptions
+type ComparisonOptions struct {
+	Position string   // only compare against players of this position when set
+	MinGames int      // minimum games played to be included in the league pool
+	Stats    []string // defaults to PlayerStatistics
+}
+
+// StatComparison compares a single stat of a player against the league.
+// Percentiles and z-scores are oriented so higher is always better.
+type StatComparison struct {
+	Stat       string  `json:"stat"`
+	Value      float64 `json:"value"`
+	Mean       float64 `json:"mean"`
+	StdDev     float64 `json:"stdDev"`
+	Percentile float64 `json:"percentile"` // 0 to 100
+	ZScore     float64 `json:"zScore"`
+}
+
+// PlayerComparison contains a player's stats compared against the
+// league.
+type PlayerComparison struct {
+	Player
+	Year          int              `json:"year"`
+	SeasonSegment string           `json:"seasonSegment"`
+	PoolSize      int              `json:"poolSize"`
+	Stats         []StatComparison `json:"stats"`
+}
+
+// ComparePlayer computes the percentile rank and z-score of each stat
+// of the given player against every player in the given seasons that
+// matches the options.
+func ComparePlayer(players []PlayerSeason, officialID string, opts ComparisonOptions) (*PlayerComparison, error) {
+	if opts.Stats == nil {
+		opts.Stats = PlayerStatistics
+	}
+
+	if err := ValidStats(opts.Stats); err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(players, func(ps PlayerSeason) bool {
+		return ps.OfficialID == officialID
+	})
+	if i == -1 {
+		return nil, errors.New("player not found: " + officialID)
+	}
+	target := players[i]
+
+	var pool []PlayerSeason
+	for _, ps := range players {
+		if opts.Position != "" && ps.Position != opts.Position {
+			continue
+		}
+		if ps.Stats.GamesPlayed < opts.MinGames {
+			continue
+		}
+		pool = append(pool, ps)
+	}
+
+	pc := PlayerComparison{
+		Player:        target.Player,
+		Year:          target.Year,
+		SeasonSegment: target.SeasonSegment,
+		PoolSize:      len(pool),
+	}
+
+	for _, stat := range opts.Stats {
+		values := make([]float64, 0, len(pool))
+		for _, ps := range pool {
+			v, _ := statValue(ps.Stats, stat)
+			values = append(values, v)
+		}
+
+		v, _ := statValue(target.Stats, stat)
+		sc := StatComparison{
+			Stat:  stat,
+			Value: v,
+		}
+		sc.Mean, sc.StdDev = meanStdDev(values)
+		sc.Percentile = percentile(values, v)
+		if sc.StdDev > 0 {
+			sc.ZScore = (v - sc.Mean) / sc.StdDev
+		}
+
+		if slices.Contains(lowerIsBetter, stat) {
+			sc.Percentile = 100 - sc.Percentile
+			sc.ZScore = -sc.ZScore
+		}
+
+		pc.Stats = append(pc.Stats, sc)
+	}
+
+	return &pc, nil
+}
+
+// meanStdDev returns the mean and population standard deviation of the
+// given values.
+func meanStdDev(values []float64) (float64, float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+
+	var ss float64
+	for _, v := range values {
+		ss += (v - mean) * (v - mean)
+	}
+
+	return mean, math.Sqrt(ss / float64(len(values)))
+}
+
+// percentile returns the percentile rank of v within the given values,
+// counting equal values as half.
+func percentile(values []float64, v float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	var below, equal int
+	for _, x := range values {
+		switch {
+		case x < v:
+			below++
+		case x == v:
+			equal++
+		}
+	}
+
+	return (float64(below) + float64(equal)/2) / float64(len(values)) * 100
+}
+
+// ComparePlayer retrieves every player's stat line for the given year
+// and season segment and compares the given player against the league.
+func (p *PLL) ComparePlayer(ctx context.Context, year int, seasonSegment, officialID string, opts ComparisonOptions) (*PlayerComparison, error) {
+	res, err := p.PlayerSeasonStats(ctx, year, seasonSegment)
+	if err != nil {
+		return nil, err
+	}
+
+	return ComparePlayer(res.AllPlayers, officialID, opts)
+}
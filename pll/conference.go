@@ -0,0 +1,102 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package pll
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// Conference
+type Conference string
+
+const (
+	EasternConference Conference = "Eastern"
+	WesternConference Conference = "Western"
+)
+
+// ConferenceStandings contains the standings of every team in a
+// conference ordered by conference seed.
+type ConferenceStandings struct {
+	Conference Conference `json:"conference"`
+	Standings  []Standing `json:"standings"`
+}
+
+// ConferenceScoreDiff returns the difference between the scores for
+// and against the team in conference games.
+func (s *Standing) ConferenceScoreDiff() int {
+	return s.ConferenceScores - s.ConferenceScoresAgainst
+}
+
+// ConferenceRecord returns the team's conference record formatted as
+// wins-losses-ties.
+func (s *Standing) ConferenceRecord() string {
+	return fmt.Sprintf("%d-%d-%d", s.ConferenceWins, s.ConferenceLosses, s.ConferenceTies)
+}
+
+// ByConference partitions the standings by conference. Conferences are
+// ordered by name and the standings within each are ordered by
+// conference seed. Teams without a conference seed are placed last in
+// order of their overall seed.
+func (r *StandingsResponse) ByConference() []ConferenceStandings {
+	var conferences []ConferenceStandings
+	idx := make(map[Conference]int)
+
+	for _, s := range r.Standings {
+		i, ok := idx[s.Conference]
+		if !ok {
+			i = len(conferences)
+			idx[s.Conference] = i
+			conferences = append(conferences, ConferenceStandings{
+				Conference: s.Conference,
+			})
+		}
+		conferences[i].Standings = append(conferences[i].Standings, s)
+	}
+
+	slices.SortFunc(conferences, func(a, b ConferenceStandings) int {
+		return cmp.Compare(a.Conference, b.Conference)
+	})
+
+	for _, c := range conferences {
+		slices.SortStableFunc(c.Standings, func(a, b Standing) int {
+			if (a.ConferenceSeed == 0) != (b.ConferenceSeed == 0) {
+				if a.ConferenceSeed == 0 {
+					return 1
+				}
+				return -1
+			}
+			if n := cmp.Compare(a.ConferenceSeed, b.ConferenceSeed); n != 0 {
+				return n
+			}
+			return cmp.Compare(a.Seed, b.Seed)
+		})
+	}
+
+	return conferences
+}
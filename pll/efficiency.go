@@ -0,0 +1,191 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package pll
+
+import "context"
+
+// TeamEfficiency contains metrics derived from a team's stat line. The
+// formulas used are:
+//
+//	possessions          = shots + turnovers
+//	offensive efficiency = scores / possessions
+//	defensive efficiency = scores against / opponent possessions
+//	net efficiency       = offensive efficiency - defensive efficiency
+//	turnover rate        = turnovers / possessions
+//	shooting efficiency  = (one point goals + 2 * two point goals) / shots
+//	two point shot rate  = two point shots / shots
+//	special teams net    = power play goals + short handed goals
+//	                       - power play goals against
+//	                       - short handed goals against
+//
+// Shot clock expirations are counted by the league as turnovers and
+// aren't added to possessions separately. When the opponent's stat
+// line isn't available, as with season totals, the team's own
+// possessions are used for the opponent since possessions alternate.
+type TeamEfficiency struct {
+	GamesPlayed             int     `json:"gamesPlayed"`
+	Possessions             float64 `json:"possessions"`
+	PossessionsPG           float64 `json:"possessionsPG"`
+	OpponentPossessions     float64 `json:"opponentPossessions"`
+	OffensiveEfficiency     float64 `json:"offensiveEfficiency"`
+	DefensiveEfficiency     float64 `json:"defensiveEfficiency"`
+	NetEfficiency           float64 `json:"netEfficiency"`
+	TurnoverRate            float64 `json:"turnoverRate"`
+	ShotClockExpirationRate float64 `json:"shotClockExpirationRate"`
+	ShootingEfficiency      float64 `json:"shootingEfficiency"`
+	TwoPointShotRate        float64 `json:"twoPointShotRate"`
+	PassesPerPossession     float64 `json:"passesPerPossession"`
+	ClearPct                float64 `json:"clearPct"`
+	RidePct                 float64 `json:"ridePct"`
+	PowerPlayPct            float64 `json:"powerPlayPct"`
+	PenaltyKillPct          float64 `json:"penaltyKillPct"`
+	SpecialTeamsNet         int     `json:"specialTeamsNet"`
+	SpecialTeamsNetPG       float64 `json:"specialTeamsNetPG"`
+}
+
+// NewTeamEfficiency derives a team's efficiency metrics from its stat
+// line and, if available, its opponent's stat line.
+func NewTeamEfficiency(s TeamStats, opponent *TeamStats) TeamEfficiency {
+	te := TeamEfficiency{
+		GamesPlayed: s.GamesPlayed,
+		Possessions: possessions(s),
+		SpecialTeamsNet: s.PowerPlayGoals + s.ShortHandedGoals -
+			s.PowerPlayGoalsAgainst - s.ShortHandedGoalsAgainst,
+	}
+
+	te.OpponentPossessions = te.Possessions
+	if opponent != nil {
+		te.OpponentPossessions = possessions(*opponent)
+	}
+
+	te.OffensiveEfficiency = ratio(s.Scores, te.Possessions)
+	te.DefensiveEfficiency = ratio(s.ScoresAgainst, te.OpponentPossessions)
+	te.NetEfficiency = te.OffensiveEfficiency - te.DefensiveEfficiency
+	te.TurnoverRate = ratio(s.Turnovers, te.Possessions)
+	te.ShotClockExpirationRate = ratio(s.ShotClockExpirations, te.Possessions)
+	te.PassesPerPossession = ratio(s.TotalPasses, te.Possessions)
+	te.ShootingEfficiency = ratio(s.OnePointGoals+2*s.TwoPointGoals, float64(s.Shots))
+	te.TwoPointShotRate = ratio(s.TwoPointShots, float64(s.Shots))
+	te.ClearPct = ratio(s.Clears, float64(s.ClearAttempts))
+	te.RidePct = ratio(s.Rides, float64(s.RideAttempts))
+	te.PowerPlayPct = ratio(s.PowerPlayGoals, float64(s.TimesManUp))
+	if s.TimesShortHanded > 0 {
+		te.PenaltyKillPct = 1 - ratio(s.PowerPlayGoalsAgainst, float64(s.TimesShortHanded))
+	}
+
+	if s.GamesPlayed > 0 {
+		te.PossessionsPG = te.Possessions / float64(s.GamesPlayed)
+		te.SpecialTeamsNetPG = float64(te.SpecialTeamsNet) / float64(s.GamesPlayed)
+	}
+
+	return te
+}
+
+// possessions returns the estimated number of possessions in the given
+// stat line.
+func possessions(s TeamStats) float64 {
+	return float64(s.Shots + s.Turnovers)
+}
+
+// ratio returns n / d or 0 if d is 0.
+func ratio(n int, d float64) float64 {
+	if d == 0 {
+		return 0
+	}
+
+	return float64(n) / d
+}
+
+// TeamSeasonEfficiency is a team along with its efficiency metrics for
+// the requested year and season segment.
+type TeamSeasonEfficiency struct {
+	Team
+	Year          int            `json:"year"`
+	SeasonSegment string         `json:"seasonSegment"`
+	Efficiency    TeamEfficiency `json:"efficiency"`
+}
+
+// TeamGameEfficiency is a team's efficiency metrics for a single game.
+type TeamGameEfficiency struct {
+	Game       Game           `json:"game"`
+	TeamID     string         `json:"teamId"`
+	Efficiency TeamEfficiency `json:"efficiency"`
+}
+
+// TeamEfficiencies retrieves every team's stat line for the given year
+// and season segment and derives their efficiency metrics.
+func (p *PLL) TeamEfficiencies(ctx context.Context, year int, seasonSegment string) ([]TeamSeasonEfficiency, error) {
+	res, err := p.TeamSeasonStats(ctx, year, seasonSegment)
+	if err != nil {
+		return nil, err
+	}
+
+	teams := make([]TeamSeasonEfficiency, 0, len(res.AllTeams))
+	for _, t := range res.AllTeams {
+		teams = append(teams, TeamSeasonEfficiency{
+			Team:          t.Team,
+			Year:          t.Year,
+			SeasonSegment: t.SeasonSegment,
+			Efficiency:    NewTeamEfficiency(t.Stats, nil),
+		})
+	}
+
+	return teams, nil
+}
+
+// GameEfficiencies retrieves the box score of the given game and
+// derives the efficiency metrics of both teams using each other's
+// stat lines.
+func (p *PLL) GameEfficiencies(ctx context.Context, gameID string) ([]TeamGameEfficiency, error) {
+	res, err := p.BoxScore(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	teams := make([]TeamGameEfficiency, 0, len(res.Event.Teams))
+	for i, t := range res.Event.Teams {
+		s := t.Stats
+		if s.GamesPlayed == 0 {
+			s.GamesPlayed = 1
+		}
+
+		var opponent *TeamStats
+		for j := range res.Event.Teams {
+			if j != i {
+				opponent = &res.Event.Teams[j].Stats
+			}
+		}
+
+		teams = append(teams, TeamGameEfficiency{
+			Game:       res.Event.Game,
+			TeamID:     t.OfficialID,
+			Efficiency: NewTeamEfficiency(s, opponent),
+		})
+	}
+
+	return teams, nil
+}
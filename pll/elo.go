@@ -0,0 +1,197 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package pll
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"slices"
+	"time"
+)
+
+const (
+	defaultEloK             = 20
+	defaultEloInitialRating = 1500
+)
+
+// EloConfig
+type EloConfig struct {
+	K                float64 // maximum rating change per game, defaults to 20
+	HomeAdvantage    float64 // rating points added to the home team
+	SeasonRegression float64 // fraction of a rating regressed to the mean each new season
+	InitialRating    float64 // rating given to new teams, defaults to 1500
+}
+
+// RatingPoint is a team's rating after a game.
+type RatingPoint struct {
+	GameID string    `json:"gameId"`
+	Year   int       `json:"year"`
+	Time   time.Time `json:"time"`
+	Rating float64   `json:"rating"`
+}
+
+// Elo rates teams using the Elo rating system.
+type Elo struct {
+	cfg     EloConfig
+	year    int
+	ratings map[string]float64
+	history map[string][]RatingPoint
+}
+
+// NewElo creates a new value of Elo with the given configuration.
+func NewElo(cfg EloConfig) *Elo {
+	if cfg.K == 0 {
+		cfg.K = defaultEloK
+	}
+
+	if cfg.InitialRating == 0 {
+		cfg.InitialRating = defaultEloInitialRating
+	}
+
+	return &Elo{
+		cfg:     cfg,
+		ratings: make(map[string]float64),
+		history: make(map[string][]RatingPoint),
+	}
+}
+
+// Process updates the ratings with the results of the given games in
+// order of start time. Games that aren't final are ignored. Ratings
+// are regressed toward the initial rating the first time a game from
+// a new season is processed.
+func (e *Elo) Process(games []Game) {
+	sorted := slices.Clone(games)
+	slices.SortStableFunc(sorted, func(a, b Game) int {
+		return cmp.Compare(a.StartTime, b.StartTime)
+	})
+
+	for _, g := range sorted {
+		if !g.Final() {
+			continue
+		}
+
+		if g.Year > e.year {
+			if e.year != 0 {
+				e.regress()
+			}
+			e.year = g.Year
+		}
+
+		home, away := g.HomeTeam.OfficialID, g.AwayTeam.OfficialID
+		expected := e.Predict(g)
+
+		actual := 0.5
+		switch {
+		case g.HomeScore > g.AwayScore:
+			actual = 1
+		case g.HomeScore < g.AwayScore:
+			actual = 0
+		}
+
+		delta := e.cfg.K * (actual - expected)
+		e.ratings[home] = e.Rating(home) + delta
+		e.ratings[away] = e.Rating(away) - delta
+
+		for _, id := range []string{home, away} {
+			e.history[id] = append(e.history[id], RatingPoint{
+				GameID: g.ID,
+				Year:   g.Year,
+				Time:   g.Start(),
+				Rating: e.ratings[id],
+			})
+		}
+	}
+}
+
+// regress moves every rating toward the initial rating by the
+// configured season regression.
+func (e *Elo) regress() {
+	for id, r := range e.ratings {
+		e.ratings[id] = r + (e.cfg.InitialRating-r)*e.cfg.SeasonRegression
+	}
+}
+
+// Rating returns the current rating of the given team.
+func (e *Elo) Rating(teamID string) float64 {
+	if r, ok := e.ratings[teamID]; ok {
+		return r
+	}
+
+	return e.cfg.InitialRating
+}
+
+// Ratings returns the current rating of every rated team.
+func (e *Elo) Ratings() map[string]float64 {
+	ratings := make(map[string]float64, len(e.ratings))
+	for id, r := range e.ratings {
+		ratings[id] = r
+	}
+
+	return ratings
+}
+
+// History returns the rating of the given team after each game it
+// played.
+func (e *Elo) History(teamID string) []RatingPoint {
+	return slices.Clone(e.history[teamID])
+}
+
+// WinProbability returns the probability of the home team beating the
+// away team given their current ratings.
+func (e *Elo) WinProbability(homeID, awayID string) float64 {
+	diff := e.Rating(homeID) + e.cfg.HomeAdvantage - e.Rating(awayID)
+	return 1 / (1 + math.Pow(10, -diff/400))
+}
+
+// Predict returns the probability of the home team winning the given
+// game.
+func (e *Elo) Predict(g Game) float64 {
+	return e.WinProbability(g.HomeTeam.OfficialID, g.AwayTeam.OfficialID)
+}
+
+// Model returns an OutcomeModel deciding games using the current
+// ratings.
+func (e *Elo) Model() OutcomeModel {
+	return ProbabilityModel{HomeWinProbability: e.Predict}
+}
+
+// EloRatings retrieves every game for the given range of years and
+// rates each team.
+func (p *PLL) EloRatings(ctx context.Context, fromYear, toYear int, cfg EloConfig) (*Elo, error) {
+	e := NewElo(cfg)
+
+	for year := fromYear; year <= toYear; year++ {
+		games, err := p.Games(ctx, year)
+		if err != nil {
+			return nil, err
+		}
+		e.Process(games.SeasonEvents)
+	}
+
+	return e, nil
+}
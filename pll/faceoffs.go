@@ -0,0 +1,215 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package pll
+
+import "context"
+
+// FaceoffStats contains a player's faceoff splits along with metrics
+// derived from the underlying counts.
+type FaceoffStats struct {
+	GamesPlayed int     `json:"gamesPlayed"`
+	Faceoffs    int     `json:"faceoffs"`
+	Wins        int     `json:"wins"`
+	Losses      int     `json:"losses"`
+	Violations  int     `json:"violations"`
+	WinPct      float64 `json:"winPct"`
+	WinsPG      float64 `json:"winsPG"`
+}
+
+// NewFaceoffStats derives a player's faceoff splits from the given
+// player stats.
+func NewFaceoffStats(s PlayerStats) FaceoffStats {
+	fs := FaceoffStats{
+		GamesPlayed: s.GamesPlayed,
+		Faceoffs:    s.Faceoffs,
+		Wins:        s.FaceoffsWon,
+		Losses:      s.FaceoffsLost,
+		Violations:  s.FaceoffViolations,
+	}
+	fs.calculate()
+
+	return fs
+}
+
+// add accumulates the counts of the given faceoff stats.
+func (fs *FaceoffStats) add(o FaceoffStats) {
+	fs.GamesPlayed += o.GamesPlayed
+	fs.Faceoffs += o.Faceoffs
+	fs.Wins += o.Wins
+	fs.Losses += o.Losses
+	fs.Violations += o.Violations
+	fs.calculate()
+}
+
+// calculate computes the derived metrics from the counts.
+func (fs *FaceoffStats) calculate() {
+	fs.WinPct, fs.WinsPG = 0, 0
+
+	if fs.Faceoffs > 0 {
+		fs.WinPct = float64(fs.Wins) / float64(fs.Faceoffs)
+	}
+
+	if fs.GamesPlayed > 0 {
+		fs.WinsPG = float64(fs.Wins) / float64(fs.GamesPlayed)
+	}
+}
+
+// FaceoffSeason is a player along with their faceoff splits for the
+// requested year and season segment.
+type FaceoffSeason struct {
+	Player
+	Year          int          `json:"year"`
+	SeasonSegment string       `json:"seasonSegment"`
+	Stats         FaceoffStats `json:"stats"`
+}
+
+// FaceoffGame is a player's faceoff splits for a single game.
+type FaceoffGame struct {
+	Game   Game         `json:"game"`
+	TeamID string       `json:"teamId"`
+	Stats  FaceoffStats `json:"stats"`
+}
+
+// FaceoffSharedGame contains the faceoff splits of two players in a
+// single game in which they played for opposing teams. The splits are
+// each player's full line for the game, not only the draws they took
+// against each other.
+type FaceoffSharedGame struct {
+	Game    Game         `json:"game"`
+	PlayerA FaceoffStats `json:"playerA"`
+	PlayerB FaceoffStats `json:"playerB"`
+}
+
+// FaceoffSharedGames contains every game two players took faceoffs
+// for opposing teams along with each player's aggregated faceoff
+// splits across those games.
+type FaceoffSharedGames struct {
+	PlayerA string              `json:"playerA"`
+	PlayerB string              `json:"playerB"`
+	Games   []FaceoffSharedGame `json:"games"`
+	SplitsA FaceoffStats        `json:"splitsA"`
+	SplitsB FaceoffStats        `json:"splitsB"`
+}
+
+// FaceoffSeasonStats retrieves the faceoff splits of every player who
+// took a faceoff during the given year and season segment.
+func (p *PLL) FaceoffSeasonStats(ctx context.Context, year int, seasonSegment string) ([]FaceoffSeason, error) {
+	res, err := p.PlayerSeasonStats(ctx, year, seasonSegment)
+	if err != nil {
+		return nil, err
+	}
+
+	var players []FaceoffSeason
+	for _, ps := range res.AllPlayers {
+		if ps.Stats.Faceoffs == 0 {
+			continue
+		}
+		players = append(players, FaceoffSeason{
+			Player:        ps.Player,
+			Year:          ps.Year,
+			SeasonSegment: ps.SeasonSegment,
+			Stats:         NewFaceoffStats(ps.Stats),
+		})
+	}
+
+	return players, nil
+}
+
+// FaceoffGameLogs retrieves the given player's faceoff splits for each
+// game played in the given year and season segment.
+func (p *PLL) FaceoffGameLogs(ctx context.Context, officialID string, year int, seasonSegment string) ([]FaceoffGame, error) {
+	res, err := p.PlayerGameLogs(ctx, officialID, year, seasonSegment)
+	if err != nil {
+		return nil, err
+	}
+
+	games := make([]FaceoffGame, 0, len(res.Player.GameLogs))
+	for _, gl := range res.Player.GameLogs {
+		s := gl.Stats
+		if s.GamesPlayed == 0 {
+			s.GamesPlayed = 1
+		}
+		games = append(games, FaceoffGame{
+			Game:   gl.Game,
+			TeamID: gl.TeamID,
+			Stats:  NewFaceoffStats(s),
+		})
+	}
+
+	return games, nil
+}
+
+// FaceoffSharedGames retrieves every game from the given range of years
+// in which both players took faceoffs for opposing teams. The API
+// doesn't report who took each draw, so the splits of each player
+// cover every faceoff they took in those games, which may include
+// draws taken against other specialists.
+func (p *PLL) FaceoffSharedGames(ctx context.Context, playerA, playerB string, fromYear, toYear int) (*FaceoffSharedGames, error) {
+	shared := FaceoffSharedGames{
+		PlayerA: playerA,
+		PlayerB: playerB,
+	}
+
+	for year := fromYear; year <= toYear; year++ {
+		for _, segment := range seasonSegments {
+			gamesA, err := p.FaceoffGameLogs(ctx, playerA, year, segment)
+			if err != nil {
+				return nil, err
+			}
+
+			gamesB, err := p.FaceoffGameLogs(ctx, playerB, year, segment)
+			if err != nil {
+				return nil, err
+			}
+
+			byGame := make(map[string]FaceoffGame, len(gamesB))
+			for _, g := range gamesB {
+				byGame[g.Game.ID] = g
+			}
+
+			for _, a := range gamesA {
+				b, ok := byGame[a.Game.ID]
+				if !ok || a.TeamID == b.TeamID {
+					continue
+				}
+				if a.Stats.Faceoffs == 0 || b.Stats.Faceoffs == 0 {
+					continue
+				}
+
+				shared.Games = append(shared.Games, FaceoffSharedGame{
+					Game:    a.Game,
+					PlayerA: a.Stats,
+					PlayerB: b.Stats,
+				})
+				shared.SplitsA.add(a.Stats)
+				shared.SplitsB.add(b.Stats)
+			}
+		}
+	}
+
+	return &shared, nil
+}
@@ -0,0 +1,251 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package pll
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"os"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FantasyRules contains the fantasy points awarded for each unit of a
+// stat. Stats are named the same as the JSON fields of PlayerStats.
+type FantasyRules struct {
+	Name   string             `json:"name" yaml:"name"`
+	Points map[string]float64 `json:"points" yaml:"points"`
+}
+
+// DefaultFantasyRules
+var DefaultFantasyRules = FantasyRules{
+	Name: "default",
+	Points: map[string]float64{
+		"onePointGoals":   4,
+		"twoPointGoals":   8,
+		"assists":         3,
+		"groundBalls":     1,
+		"causedTurnovers": 2,
+		"turnovers":       -1,
+		"faceoffsWon":     0.5,
+		"faceoffsLost":    -0.5,
+		"saves":           1,
+		"goalsAgainst":    -0.5,
+	},
+}
+
+// ParseFantasyRules parses the given YAML or JSON encoded rules.
+func ParseFantasyRules(data []byte) (*FantasyRules, error) {
+	var rules FantasyRules
+	if err := yaml.Unmarshal(data, &rules); err != nil {
+		return nil, err
+	}
+
+	if err := rules.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &rules, nil
+}
+
+// LoadFantasyRules reads and parses the YAML or JSON encoded rules in
+// the given file.
+func LoadFantasyRules(path string) (*FantasyRules, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseFantasyRules(data)
+}
+
+// Validate checks to see if every stat in the rules is valid.
+func (r *FantasyRules) Validate() error {
+	if len(r.Points) == 0 {
+		return errors.New("no fantasy scoring rules")
+	}
+
+	for stat := range r.Points {
+		if _, ok := statValue(PlayerStats{}, stat); !ok {
+			return errors.New("invalid stat: " + stat)
+		}
+	}
+
+	return nil
+}
+
+// Score returns the fantasy points earned by the given stat line.
+func (r *FantasyRules) Score(s PlayerStats) float64 {
+	var points float64
+	for stat, p := range r.Points {
+		v, _ := statValue(s, stat)
+		points += v * p
+	}
+
+	return points
+}
+
+// FantasyLeader is a player's fantasy points over a number of games.
+type FantasyLeader struct {
+	Player
+	TeamID   string  `json:"teamId"`
+	Games    int     `json:"games"`
+	Points   float64 `json:"points"`
+	PointsPG float64 `json:"pointsPG"`
+	Rank     int     `json:"rank"`
+}
+
+// FantasyWeek contains the fantasy leaderboard of a single week.
+type FantasyWeek struct {
+	Year    int             `json:"year"`
+	Week    int             `json:"week"`
+	Leaders []FantasyLeader `json:"leaders"`
+}
+
+// SeasonLeaders returns the fantasy leaderboard for the given seasons.
+func (r *FantasyRules) SeasonLeaders(players []PlayerSeason) []FantasyLeader {
+	leaders := make([]FantasyLeader, 0, len(players))
+	for _, ps := range players {
+		leaders = append(leaders, FantasyLeader{
+			Player: ps.Player,
+			TeamID: ps.Team.OfficialID,
+			Games:  ps.Stats.GamesPlayed,
+			Points: r.Score(ps.Stats),
+		})
+	}
+
+	return rankFantasyLeaders(leaders)
+}
+
+// GameLeaders returns the fantasy leaderboard of the given box scores,
+// accumulating the points each player earned across the games.
+func (r *FantasyRules) GameLeaders(boxScores []BoxScore) []FantasyLeader {
+	var leaders []FantasyLeader
+	idx := make(map[string]int)
+
+	for _, bs := range boxScores {
+		for _, bp := range bs.Players {
+			i, ok := idx[bp.OfficialID]
+			if !ok {
+				i = len(leaders)
+				idx[bp.OfficialID] = i
+				leaders = append(leaders, FantasyLeader{
+					Player: bp.Player,
+					TeamID: bp.TeamID,
+				})
+			}
+			leaders[i].Games++
+			leaders[i].Points += r.Score(bp.Stats)
+		}
+	}
+
+	return rankFantasyLeaders(leaders)
+}
+
+// WeeklyLeaders groups the given box scores by week and returns the
+// fantasy leaderboard of each week in order.
+func (r *FantasyRules) WeeklyLeaders(boxScores []BoxScore) []FantasyWeek {
+	type key struct{ year, week int }
+
+	var keys []key
+	byWeek := make(map[key][]BoxScore)
+	for _, bs := range boxScores {
+		k := key{bs.Year, bs.Week}
+		if _, ok := byWeek[k]; !ok {
+			keys = append(keys, k)
+		}
+		byWeek[k] = append(byWeek[k], bs)
+	}
+
+	slices.SortFunc(keys, func(a, b key) int {
+		if n := cmp.Compare(a.year, b.year); n != 0 {
+			return n
+		}
+		return cmp.Compare(a.week, b.week)
+	})
+
+	weeks := make([]FantasyWeek, 0, len(keys))
+	for _, k := range keys {
+		weeks = append(weeks, FantasyWeek{
+			Year:    k.year,
+			Week:    k.week,
+			Leaders: r.GameLeaders(byWeek[k]),
+		})
+	}
+
+	return weeks
+}
+
+// rankFantasyLeaders orders the given leaders by points and assigns
+// their ranks. Ties share a rank.
+func rankFantasyLeaders(leaders []FantasyLeader) []FantasyLeader {
+	for i := range leaders {
+		if leaders[i].Games > 0 {
+			leaders[i].PointsPG = leaders[i].Points / float64(leaders[i].Games)
+		}
+	}
+
+	slices.SortStableFunc(leaders, func(a, b FantasyLeader) int {
+		return cmp.Compare(b.Points, a.Points)
+	})
+
+	for i := range leaders {
+		if i > 0 && leaders[i].Points == leaders[i-1].Points {
+			leaders[i].Rank = leaders[i-1].Rank
+			continue
+		}
+		leaders[i].Rank = i + 1
+	}
+
+	return leaders
+}
+
+// FantasyWeeks retrieves the box score of every completed game of the
+// given year and returns the weekly fantasy leaderboards.
+func (p *PLL) FantasyWeeks(ctx context.Context, year int, rules *FantasyRules) ([]FantasyWeek, error) {
+	games, err := p.seasonGames(ctx, year)
+	if err != nil {
+		return nil, err
+	}
+
+	var boxScores []BoxScore
+	for _, g := range games {
+		if !g.Final() {
+			continue
+		}
+
+		res, err := p.BoxScore(ctx, g.ID)
+		if err != nil {
+			return nil, err
+		}
+		boxScores = append(boxScores, res.Event)
+	}
+
+	return rules.WeeklyLeaders(boxScores), nil
+}
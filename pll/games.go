@@ -0,0 +1,220 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package pll
+
+import (
+	"context"
+	"time"
+)
+
+// gameStatusFinal is the event status reported for completed games.
+const gameStatusFinal = 3
+
+// regulationMinutes is the length of a regulation PLL game, four
+// twelve minute quarters.
+const regulationMinutes = 48
+
+// UnixTime is a time in seconds since the epoch.
+type UnixTime int64
+
+// Time returns the time as a time.Time.
+func (t UnixTime) Time() time.Time {
+	return time.Unix(int64(t), 0)
+}
+
+// Game
+type Game struct {
+	ID            string   `json:"id"`
+	Slug          string   `json:"slugname"`
+	Year          int      `json:"year"`
+	Week          int      `json:"week"`
+	StartTime     UnixTime `json:"startTime"`
+	SeasonSegment string   `json:"seasonSegment"`
+	EventStatus   int      `json:"eventStatus"`
+	HomeTeam      Team     `json:"homeTeam"`
+	AwayTeam      Team     `json:"awayTeam"`
+	HomeScore     int      `json:"homeScore"`
+	AwayScore     int      `json:"visitorScore"`
+}
+
+// Final reports whether the game has been completed.
+func (g *Game) Final() bool {
+	return g.EventStatus == gameStatusFinal
+}
+
+// Start returns the scheduled start time of the game.
+func (g *Game) Start() time.Time {
+	return g.StartTime.Time()
+}
+
+// GamesResponse
+type GamesResponse struct {
+	SeasonEvents []Game `json:"seasonEvents"`
+}
+
+// Games retrieves every game scheduled for the given year, including
+// the Championship Series.
+func (p *PLL) Games(ctx context.Context, year int) (*GamesResponse, error) {
+	req := p.newRequest(gamesQuery)
+	req.Var("year", year)
+
+	var res GamesResponse
+	if err := p.client.Run(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// seasonGames returns every game for the given year. Seasons in which
+// every game is final are cached.
+func (p *PLL) seasonGames(ctx context.Context, year int) ([]Game, error) {
+	p.mu.Lock()
+	games, ok := p.seasons[year]
+	p.mu.Unlock()
+	if ok {
+		return games, nil
+	}
+
+	res, err := p.Games(ctx, year)
+	if err != nil {
+		return nil, err
+	}
+
+	complete := len(res.SeasonEvents) > 0
+	for _, g := range res.SeasonEvents {
+		if !g.Final() {
+			complete = false
+			break
+		}
+	}
+
+	if complete {
+		p.mu.Lock()
+		p.seasons[year] = res.SeasonEvents
+		p.mu.Unlock()
+	}
+
+	return res.SeasonEvents, nil
+}
+
+// BoxScoreTeam is a team's stat line for a single game.
+type BoxScoreTeam struct {
+	OfficialID string    `json:"officialId"`
+	Stats      TeamStats `json:"stats"`
+}
+
+// BoxScorePlayer is a player's stat line for a single game.
+type BoxScorePlayer struct {
+	Player
+	TeamID string      `json:"teamId"`
+	Stats  PlayerStats `json:"stats"`
+}
+
+// BoxScore contains the team and player stat lines of a single game.
+type BoxScore struct {
+	Game
+	Teams   []BoxScoreTeam   `json:"teams"`
+	Players []BoxScorePlayer `json:"players"`
+}
+
+// Team returns the stat line of the given team.
+func (b *BoxScore) Team(teamID string) (TeamStats, bool) {
+	for _, t := range b.Teams {
+		if t.OfficialID == teamID {
+			return t.Stats, true
+		}
+	}
+
+	return TeamStats{}, false
+}
+
+// BoxScoreResponse
+type BoxScoreResponse struct {
+	Event BoxScore `json:"event"`
+}
+
+// BoxScore retrieves the team and player stat lines of the given game.
+// Box scores of final games are cached.
+func (p *PLL) BoxScore(ctx context.Context, gameID string) (*BoxScoreResponse, error) {
+	p.mu.Lock()
+	bs, ok := p.boxScores[gameID]
+	p.mu.Unlock()
+	if ok {
+		return &BoxScoreResponse{Event: *bs}, nil
+	}
+
+	req := p.newRequest(boxScoreQuery)
+	req.Var("id", gameID)
+
+	var res BoxScoreResponse
+	if err := p.client.Run(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	if res.Event.Final() {
+		p.mu.Lock()
+		p.boxScores[gameID] = &res.Event
+		p.mu.Unlock()
+	}
+
+	return &res, nil
+}
+
+// PlayerGameLog is a player's stat line for a single game.
+type PlayerGameLog struct {
+	Game   Game        `json:"event"`
+	TeamID string      `json:"teamId"`
+	Stats  PlayerStats `json:"stats"`
+}
+
+// PlayerGameLogsResponse
+type PlayerGameLogsResponse struct {
+	Player struct {
+		GameLogs []PlayerGameLog `json:"gameLogs"`
+	} `json:"player"`
+}
+
+// PlayerGameLogs retrieves the given player's stat line for each game
+// played in the given year and season segment.
+func (p *PLL) PlayerGameLogs(ctx context.Context, officialID string, year int, seasonSegment string) (*PlayerGameLogsResponse, error) {
+	if err := ValidSeasonSegment(seasonSegment); err != nil {
+		return nil, err
+	}
+
+	req := p.newRequest(playerGameLogsQuery)
+	req.Var("id", officialID)
+	req.Var("year", year)
+	req.Var("segment", seasonSegment)
+
+	var res PlayerGameLogsResponse
+	if err := p.client.Run(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,167 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package pll
+
+import "context"
+
+// goaliePosition is the position code used for goalies.
+const goaliePosition = "G"
+
+// GoalieStats contains a goalie's stat line along with metrics derived
+// from the underlying counts.
+type GoalieStats struct {
+	GamesPlayed          int     `json:"gamesPlayed"`
+	Wins                 int     `json:"wins"`
+	Losses               int     `json:"losses"`
+	Ties                 int     `json:"ties"`
+	MinutesPlayed        float64 `json:"minutesPlayed"`
+	Saves                int     `json:"saves"`
+	GoalsAgainst         int     `json:"goalsAgainst"`
+	TwoPointGoalsAgainst int     `json:"twoPointGoalsAgainst"`
+	ScoresAgainst        int     `json:"scoresAgainst"`
+	ShotsOnGoalAgainst   int     `json:"shotsOnGoalAgainst"`
+	SavePct              float64 `json:"savePct"`
+	ScoresAgainstAverage float64 `json:"scoresAgainstAverage"`
+	GoalsAgainstAverage  float64 `json:"goalsAgainstAverage"`
+	SavesPG              float64 `json:"savesPG"`
+}
+
+// NewGoalieStats derives a goalie's stat line from the given player
+// stats. Every goalie metric is computed the same way for seasons and
+// single games:
+//
+//	shots on goal against  = saves + goals against
+//	save pct               = saves / shots on goal against
+//	scores against average = scores against * 48 / minutes played
+//	goals against average  = goals against * 48 / minutes played
+//	saves per game         = saves / games played
+//
+// When minutes played are not reported, a full regulation game is
+// assumed for each game played.
+func NewGoalieStats(s PlayerStats) GoalieStats {
+	gs := GoalieStats{
+		GamesPlayed:          s.GamesPlayed,
+		Wins:                 s.GoalieWins,
+		Losses:               s.GoalieLosses,
+		Ties:                 s.GoalieTies,
+		MinutesPlayed:        minutesPlayed(s),
+		Saves:                s.Saves,
+		GoalsAgainst:         s.GoalsAgainst,
+		TwoPointGoalsAgainst: s.TwoPointGoalsAgainst,
+		ScoresAgainst:        s.ScoresAgainst,
+		ShotsOnGoalAgainst:   s.Saves + s.GoalsAgainst,
+	}
+
+	if gs.ShotsOnGoalAgainst > 0 {
+		gs.SavePct = float64(gs.Saves) / float64(gs.ShotsOnGoalAgainst)
+	}
+
+	if gs.MinutesPlayed > 0 {
+		gs.ScoresAgainstAverage = float64(gs.ScoresAgainst) * regulationMinutes / gs.MinutesPlayed
+		gs.GoalsAgainstAverage = float64(gs.GoalsAgainst) * regulationMinutes / gs.MinutesPlayed
+	}
+
+	if gs.GamesPlayed > 0 {
+		gs.SavesPG = float64(gs.Saves) / float64(gs.GamesPlayed)
+	}
+
+	return gs
+}
+
+// minutesPlayed returns the player's time on field in minutes or, when
+// it isn't reported, a full regulation game for each game played.
+func minutesPlayed(s PlayerStats) float64 {
+	if s.Tof > 0 {
+		return s.Tof
+	}
+
+	return float64(s.GamesPlayed * regulationMinutes)
+}
+
+// GoalieSeason is a goalie along with their stat line for the
+// requested year and season segment.
+type GoalieSeason struct {
+	Player
+	Year          int         `json:"year"`
+	SeasonSegment string      `json:"seasonSegment"`
+	Stats         GoalieStats `json:"stats"`
+}
+
+// GoalieGame is a goalie's stat line for a single game.
+type GoalieGame struct {
+	Game   Game        `json:"game"`
+	TeamID string      `json:"teamId"`
+	Stats  GoalieStats `json:"stats"`
+}
+
+// GoalieSeasonStats retrieves the stat line of every goalie who
+// appeared in a game during the given year and season segment.
+func (p *PLL) GoalieSeasonStats(ctx context.Context, year int, seasonSegment string) ([]GoalieSeason, error) {
+	res, err := p.PlayerSeasonStats(ctx, year, seasonSegment)
+	if err != nil {
+		return nil, err
+	}
+
+	var goalies []GoalieSeason
+	for _, ps := range res.AllPlayers {
+		if ps.Position != goaliePosition {
+			continue
+		}
+		goalies = append(goalies, GoalieSeason{
+			Player:        ps.Player,
+			Year:          ps.Year,
+			SeasonSegment: ps.SeasonSegment,
+			Stats:         NewGoalieStats(ps.Stats),
+		})
+	}
+
+	return goalies, nil
+}
+
+// GoalieGameLogs retrieves the given goalie's stat line for each game
+// played in the given year and season segment.
+func (p *PLL) GoalieGameLogs(ctx context.Context, officialID string, year int, seasonSegment string) ([]GoalieGame, error) {
+	res, err := p.PlayerGameLogs(ctx, officialID, year, seasonSegment)
+	if err != nil {
+		return nil, err
+	}
+
+	games := make([]GoalieGame, 0, len(res.Player.GameLogs))
+	for _, gl := range res.Player.GameLogs {
+		s := gl.Stats
+		if s.GamesPlayed == 0 {
+			s.GamesPlayed = 1
+		}
+		games = append(games, GoalieGame{
+			Game:   gl.Game,
+			TeamID: gl.TeamID,
+			Stats:  NewGoalieStats(s),
+		})
+	}
+
+	return games, nil
+}
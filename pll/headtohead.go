@@ -0,0 +1,137 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package pll
+
+import "context"
+
+// HeadToHeadGame is a single meeting between two teams from the
+// perspective of the first team.
+type HeadToHeadGame struct {
+	Game           Game `json:"game"`
+	ScoresA        int  `json:"scoresA"`
+	ScoresB        int  `json:"scoresB"`
+	OnePointGoalsA int  `json:"onePointGoalsA"`
+	OnePointGoalsB int  `json:"onePointGoalsB"`
+	TwoPointGoalsA int  `json:"twoPointGoalsA"`
+	TwoPointGoalsB int  `json:"twoPointGoalsB"`
+	Margin         int  `json:"margin"` // scores of the first team minus the second
+}
+
+// HeadToHeadRecord contains every meeting between two teams along with
+// their aggregated record.
+type HeadToHeadRecord struct {
+	TeamA          string           `json:"teamA"`
+	TeamB          string           `json:"teamB"`
+	Games          []HeadToHeadGame `json:"games"`
+	WinsA          int              `json:"winsA"`
+	WinsB          int              `json:"winsB"`
+	Ties           int              `json:"ties"`
+	ScoresA        int              `json:"scoresA"`
+	ScoresB        int              `json:"scoresB"`
+	OnePointGoalsA int              `json:"onePointGoalsA"`
+	OnePointGoalsB int              `json:"onePointGoalsB"`
+	TwoPointGoalsA int              `json:"twoPointGoalsA"`
+	TwoPointGoalsB int              `json:"twoPointGoalsB"`
+	LargestWinA    *HeadToHeadGame  `json:"largestWinA"`
+	LargestWinB    *HeadToHeadGame  `json:"largestWinB"`
+}
+
+// HeadToHead retrieves every completed meeting between the two given
+// teams from the given range of years, in every season segment, along
+// with the goal splits of each game from its box score. Completed
+// seasons and box scores are cached.
+func (p *PLL) HeadToHead(ctx context.Context, teamA, teamB string, fromYear, toYear int) (*HeadToHeadRecord, error) {
+	h2h := HeadToHeadRecord{
+		TeamA: teamA,
+		TeamB: teamB,
+	}
+
+	for year := fromYear; year <= toYear; year++ {
+		games, err := p.seasonGames(ctx, year)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, g := range games {
+			if !g.Final() {
+				continue
+			}
+
+			var hg HeadToHeadGame
+			switch {
+			case g.HomeTeam.OfficialID == teamA && g.AwayTeam.OfficialID == teamB:
+				hg = HeadToHeadGame{Game: g, ScoresA: g.HomeScore, ScoresB: g.AwayScore}
+			case g.HomeTeam.OfficialID == teamB && g.AwayTeam.OfficialID == teamA:
+				hg = HeadToHeadGame{Game: g, ScoresA: g.AwayScore, ScoresB: g.HomeScore}
+			default:
+				continue
+			}
+			hg.Margin = hg.ScoresA - hg.ScoresB
+
+			bs, err := p.BoxScore(ctx, g.ID)
+			if err != nil {
+				return nil, err
+			}
+			if s, ok := bs.Event.Team(teamA); ok {
+				hg.OnePointGoalsA, hg.TwoPointGoalsA = s.OnePointGoals, s.TwoPointGoals
+			}
+			if s, ok := bs.Event.Team(teamB); ok {
+				hg.OnePointGoalsB, hg.TwoPointGoalsB = s.OnePointGoals, s.TwoPointGoals
+			}
+
+			h2h.add(hg)
+		}
+	}
+
+	return &h2h, nil
+}
+
+// add accumulates the given game into the record.
+func (h *HeadToHeadRecord) add(hg HeadToHeadGame) {
+	h.Games = append(h.Games, hg)
+	h.ScoresA += hg.ScoresA
+	h.ScoresB += hg.ScoresB
+	h.OnePointGoalsA += hg.OnePointGoalsA
+	h.OnePointGoalsB += hg.OnePointGoalsB
+	h.TwoPointGoalsA += hg.TwoPointGoalsA
+	h.TwoPointGoalsB += hg.TwoPointGoalsB
+
+	switch {
+	case hg.Margin > 0:
+		h.WinsA++
+		if h.LargestWinA == nil || hg.Margin > h.LargestWinA.Margin {
+			h.LargestWinA = &hg
+		}
+	case hg.Margin < 0:
+		h.WinsB++
+		if h.LargestWinB == nil || hg.Margin < h.LargestWinB.Margin {
+			h.LargestWinB = &hg
+		}
+	default:
+		h.Ties++
+	}
+}
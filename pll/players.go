@@ -0,0 +1,141 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package pll
+
+import "context"
+
+// Player
+type Player struct {
+	OfficialID   string `json:"officialId"`
+	ProfileURL   string `json:"profileUrl"`
+	FirstName    string `json:"firstName"`
+	LastName     string `json:"lastName"`
+	Slug         string `json:"slug"`
+	JerseyNum    string `json:"jerseyNum"`
+	Handedness   string `json:"handedness"`
+	Position     string `json:"position"`
+	PositionName string `json:"positionName"`
+	Team         Team   `json:"team"`
+}
+
+// PlayerStats contains a player's complete stat line for either a
+// season segment or a single game.
+type PlayerStats struct {
+	GamesPlayed          int     `json:"gamesPlayed"`
+	Points               int     `json:"points"`
+	Goals                int     `json:"goals"`
+	OnePointGoals        int     `json:"onePointGoals"`
+	TwoPointGoals        int     `json:"twoPointGoals"`
+	ScoringPoints        int     `json:"scoringPoints"`
+	Assists              int     `json:"assists"`
+	Shots                int     `json:"shots"`
+	ShotsOnGoal          int     `json:"shotsOnGoal"`
+	TwoPointShots        int     `json:"twoPointShots"`
+	TwoPointShotsOnGoal  int     `json:"twoPointShotsOnGoal"`
+	ShotPct              float64 `json:"shotPct"`
+	TwoPointShotPct      float64 `json:"twoPointShotPct"`
+	ShotsOnGoalPct       float64 `json:"shotsOnGoalPct"`
+	Touches              int     `json:"touches"`
+	TotalPasses          int     `json:"totalPasses"`
+	GroundBalls          int     `json:"groundBalls"`
+	Turnovers            int     `json:"turnovers"`
+	CausedTurnovers      int     `json:"causedTurnovers"`
+	Faceoffs             int     `json:"faceoffs"`
+	FaceoffsWon          int     `json:"faceoffsWon"`
+	FaceoffsLost         int     `json:"faceoffsLost"`
+	FaceoffPct           float64 `json:"faceoffPct"`
+	FaceoffViolations    int     `json:"faceoffViolations"`
+	Saves                int     `json:"saves"`
+	SavePct              float64 `json:"savePct"`
+	GoalsAgainst         int     `json:"goalsAgainst"`
+	TwoPointGoalsAgainst int     `json:"twoPointGoalsAgainst"`
+	ScoresAgainst        int     `json:"scoresAgainst"`
+	Saa                  float64 `json:"saa"`
+	NumPenalties         int     `json:"numPenalties"`
+	Pim                  float64 `json:"pim"`
+	PowerPlayGoals       int     `json:"powerPlayGoals"`
+	PowerPlayShots       int     `json:"powerPlayShots"`
+	ShortHandedGoals     int     `json:"shortHandedGoals"`
+	ShortHandedShots     int     `json:"shortHandedShots"`
+	GoalieWins           int     `json:"goalieWins"`
+	GoalieLosses         int     `json:"goalieLosses"`
+	GoalieTies           int     `json:"goalieTies"`
+	Tof                  float64 `json:"tof"` // time on field in minutes
+	PointsPG             float64 `json:"pointsPG"`
+	OnePointGoalsPG      float64 `json:"onePointGoalsPG"`
+	AssistsPG            float64 `json:"assistsPG"`
+	ShotsPG              float64 `json:"shotsPG"`
+	TouchesPG            float64 `json:"touchesPG"`
+	FaceoffWinsPG        float64 `json:"faceoffWinsPG"`
+	SavesPG              float64 `json:"savesPG"`
+	CausedTurnoversPG    float64 `json:"causedTurnoversPG"`
+	GroundBallsPG        float64 `json:"groundBallsPG"`
+}
+
+// PlayerSeason is a player along with their stat line for the
+// requested year and season segment.
+type PlayerSeason struct {
+	Player
+	Year          int         `json:"year"`
+	SeasonSegment string      `json:"seasonSegment"`
+	Stats         PlayerStats `json:"stats"`
+}
+
+// PlayerSeasonStatsResponse
+type PlayerSeasonStatsResponse struct {
+	AllPlayers []PlayerSeason `json:"allPlayers"`
+}
+
+// PlayerSeasonStats retrieves the complete stat line of every player
+// who appeared in a game during the given year and season segment.
+func (p *PLL) PlayerSeasonStats(ctx context.Context, year int, seasonSegment string) (*PlayerSeasonStatsResponse, error) {
+	if err := ValidSeasonSegment(seasonSegment); err != nil {
+		return nil, err
+	}
+
+	req := p.newRequest(playerSeasonStatsQuery)
+	req.Var("year", year)
+	req.Var("segment", seasonSegment)
+
+	var res PlayerSeasonStatsResponse
+	if err := p.client.Run(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	players := res.AllPlayers[:0]
+	for _, ps := range res.AllPlayers {
+		if ps.Stats.GamesPlayed == 0 {
+			continue
+		}
+		ps.Year = year
+		ps.SeasonSegment = seasonSegment
+		players = append(players, ps)
+	}
+	res.AllPlayers = players
+
+	return &res, nil
+}
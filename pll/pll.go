@@ -33,7 +33,9 @@ import (
 	"context"
 	"errors"
 	"slices"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/machinebox/graphql"
 )
@@ -81,21 +83,21 @@ type Team struct {
 
 // Standing
 type Standing struct {
-	Conference              any  `json:"conference"`
-	ConferenceLosses        int  `json:"conferenceLosses"`
-	ConferenceScores        int  `json:"conferenceScores"`
-	ConferenceScoresAgainst int  `json:"conferenceScoresAgainst"`
-	ConferenceSeed          any  `json:"conferenceSeed"`
-	ConferenceTies          int  `json:"conferenceTies"`
-	ConferenceWins          int  `json:"conferenceWins"`
-	Losses                  int  `json:"losses"`
-	ScoreDiff               int  `json:"scoreDiff"`
-	Scores                  int  `json:"scores"`
-	ScoresAgainst           int  `json:"scoresAgainst"`
-	Seed                    int  `json:"seed"`
-	Team                    Team `json:"team"`
-	Ties                    int  `json:"ties"`
-	Wins                    int  `json:"wins"`
+	Conference              Conference `json:"conference"`
+	ConferenceLosses        int        `json:"conferenceLosses"`
+	ConferenceScores        int        `json:"conferenceScores"`
+	ConferenceScoresAgainst int        `json:"conferenceScoresAgainst"`
+	ConferenceSeed          int        `json:"conferenceSeed"`
+	ConferenceTies          int        `json:"conferenceTies"`
+	ConferenceWins          int        `json:"conferenceWins"`
+	Losses                  int        `json:"losses"`
+	ScoreDiff               int        `json:"scoreDiff"`
+	Scores                  int        `json:"scores"`
+	ScoresAgainst           int        `json:"scoresAgainst"`
+	Seed                    int        `json:"seed"`
+	Team                    Team       `json:"team"`
+	Ties                    int        `json:"ties"`
+	Wins                    int        `json:"wins"`
 }
 
 // StandingsResponse
@@ -121,32 +123,107 @@ type PlayerStatLeader struct {
 
 // PlayerStatsResponse
 type PlayerStatsResponse struct {
-	Data struct {
-		PlayerStatLeaders []PlayerStatLeader `json:"playerStatLeaders"`
-	} `json:"data"`
+	PlayerStatLeaders []PlayerStatLeader `json:"playerStatLeaders"`
+}
+
+// PlayerStatValue is a single stat value along with the player's
+// rank for that stat.
+type PlayerStatValue struct {
+	Value string `json:"statValue"`
+	Rank  int    `json:"playerRank"`
+}
+
+// Float parses the stat value as a float64.
+func (v PlayerStatValue) Float() (float64, error) {
+	return strconv.ParseFloat(v.Value, 64)
+}
+
+// PlayerLeaderRecord contains every stat returned for a single player
+// in a PlayerStatsResponse, keyed by stat type.
+type PlayerLeaderRecord struct {
+	OfficialID string                     `json:"officialId"`
+	ProfileURL string                     `json:"profileUrl"`
+	FirstName  string                     `json:"firstName"`
+	LastName   string                     `json:"lastName"`
+	Position   string                     `json:"position"`
+	Slug       string                     `json:"slug"`
+	JerseyNum  string                     `json:"jerseyNum"`
+	TeamID     string                     `json:"teamId"`
+	Year       int                        `json:"year"`
+	Stats      map[string]PlayerStatValue `json:"stats"`
+}
+
+// ByPlayer pivots the flat list of stat leader rows into one record
+// per player. Records are returned in the order each player first
+// appears in the response.
+func (r *PlayerStatsResponse) ByPlayer() []PlayerLeaderRecord {
+	var records []PlayerLeaderRecord
+	idx := make(map[string]int)
+
+	for _, l := range r.PlayerStatLeaders {
+		i, ok := idx[l.OfficialID]
+		if !ok {
+			i = len(records)
+			idx[l.OfficialID] = i
+			records = append(records, PlayerLeaderRecord{
+				OfficialID: l.OfficialID,
+				ProfileURL: l.ProfileURL,
+				FirstName:  l.FirstName,
+				LastName:   l.LastName,
+				Position:   l.Position,
+				Slug:       l.Slug,
+				JerseyNum:  l.JerseyNum,
+				TeamID:     l.TeamID,
+				Year:       l.Year,
+				Stats:      make(map[string]PlayerStatValue),
+			})
+		}
+		records[i].Stats[l.StatType] = PlayerStatValue{
+			Value: l.StatValue,
+			Rank:  l.PlayerRank,
+		}
+	}
+
+	return records
 }
 
 // PLL
 type PLL struct {
 	token  string
 	client *graphql.Client
+
+	// mu protects the caches of completed seasons and box scores of
+	// completed games.
+	mu        sync.Mutex
+	seasons   map[int][]Game
+	boxScores map[string]*BoxScore
 }
 
 // NewPLL creates a new value of PLL with an initialized
 // GraphQL client using the given token.
 func NewPLL(token string) *PLL {
 	return &PLL{
-		token:  token,
-		client: graphql.NewClient(graphqlEndpoint),
+		token:     token,
+		client:    graphql.NewClient(graphqlEndpoint),
+		seasons:   make(map[int][]Game),
+		boxScores: make(map[string]*BoxScore),
 	}
 }
 
+// newRequest creates a new GraphQL request for the given query
+// with the authorization header set.
+func (p *PLL) newRequest(query string) *graphql.Request {
+	req := graphql.NewRequest(query)
+	req.Header.Set("Authorization", "Bearer "+p.token)
+
+	return req
+}
+
 // Standings
 func (p *PLL) Standings(ctx context.Context, year int, champSeries bool) (*StandingsResponse, error) {
-	req := graphql.NewRequest(standingsQuery)
+	req := p.newRequest(standingsQuery)
 	req.Var("year", year)
 	req.Var("champSeries", champSeries)
-	req.Header.Set("Authorization", "Bearer "+p.token)
 
 	var res StandingsResponse
 	if err := p.client.Run(ctx, req, &res); err != nil {
@@ -166,12 +243,11 @@ func (p *PLL) PlayerStats(ctx context.Context, year, limit int, seasonSegment st
 		return nil, errors.New("invalid stats")
 	}
 
-	req := graphql.NewRequest(playerStatsQuery)
+	req := p.newRequest(playerStatsQuery)
 	req.Var("year", year)
 	req.Var("seasonSegment", seasonSegment)
 	req.Var("statList", strings.Join(stats, ","))
 	req.Var("limit", limit)
-	req.Header.Set("Authorization", "Bearer "+p.token)
 
 	var res PlayerStatsResponse
 	if err := p.client.Run(ctx, req, &res); err != nil {
@@ -181,6 +257,11 @@ func (p *PLL) PlayerStats(ctx context.Context, year, limit int, seasonSegment st
 	return &res, nil
 }
 
+// Segments returns the valid season segments.
+func Segments() []string {
+	return slices.Clone(seasonSegments)
+}
+
 // ValidSeasonSegment checks to see if the given season
 // segment is valid.
 func ValidSeasonSegment(segment string) error {
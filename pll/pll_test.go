@@ -0,0 +1,109 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package pll
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/machinebox/graphql"
+)
+
+// newTestPLL returns a client whose requests are answered with the
+// contents of the given testdata file.
+func newTestPLL(t *testing.T, file string) *PLL {
+	t.Helper()
+
+	body, err := os.ReadFile("testdata/" + file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer token" {
+			t.Errorf("Authorization = %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	}))
+	t.Cleanup(srv.Close)
+
+	p := NewPLL("token")
+	p.client = graphql.NewClient(srv.URL)
+
+	return p
+}
+
+func TestPlayerStatsByPlayer(t *testing.T) {
+	p := newTestPLL(t, "player_stat_leaders.json")
+
+	res, err := p.PlayerStats(context.Background(), 2024, 10, "regular", []string{"points", "assists"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res.PlayerStatLeaders) != 3 {
+		t.Fatalf("got %d leaders, want 3", len(res.PlayerStatLeaders))
+	}
+
+	records := res.ByPlayer()
+	if len(records) != 2 {
+		t.Fatalf("got %d records, want 2", len(records))
+	}
+
+	teat := records[0]
+	if teat.OfficialID != "c3f8a8b4" || teat.LastName != "Teat" || teat.TeamID != "RED" {
+		t.Errorf("records[0] = %+v", teat)
+	}
+
+	tests := []struct {
+		stat  string
+		value float64
+		rank  int
+	}{
+		{"points", 55, 1},
+		{"assists", 28, 1},
+	}
+	for _, tt := range tests {
+		v, ok := teat.Stats[tt.stat]
+		if !ok {
+			t.Errorf("missing stat %s", tt.stat)
+			continue
+		}
+		f, err := v.Float()
+		if err != nil || f != tt.value || v.Rank != tt.rank {
+			t.Errorf("%s = %+v, want %v rank %d", tt.stat, v, tt.value, tt.rank)
+		}
+	}
+
+	if v := records[1].Stats["points"]; records[1].LastName != "O'Neill" || v.Value != "41" || v.Rank != 2 {
+		t.Errorf("records[1] = %+v", records[1])
+	}
+}
@@ -0,0 +1,347 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package pll
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+)
+
+// Prediction is the predicted outcome of a game.
+type Prediction struct {
+	Game               Game    `json:"game"`
+	HomeWinProbability float64 `json:"homeWinProbability"`
+	Spread             float64 `json:"spread"` // expected home scores minus away scores
+}
+
+// Predictor predicts the outcome of games after being trained on the
+// results of completed games.
+type Predictor interface {
+	Train(games []Game)
+	Predict(g Game) Prediction
+}
+
+// EloPredictor predicts games using the difference in the teams' Elo
+// ratings. A logistic regression of results and a linear regression of
+// margins on the pre-game rating difference are fitted to the training
+// games so predicted probabilities and spreads are calibrated to PLL
+// results.
+type EloPredictor struct {
+	cfg EloConfig
+	elo *Elo
+
+	// logistic regression coefficients for the home win probability
+	// and linear regression coefficients for the spread
+	a, b           float64
+	intercept, per float64
+}
+
+// NewEloPredictor creates a new value of EloPredictor with the given
+// Elo configuration.
+func NewEloPredictor(cfg EloConfig) *EloPredictor {
+	return &EloPredictor{
+		cfg: cfg,
+		elo: NewElo(cfg),
+		b:   math.Ln10 / 400,
+	}
+}
+
+// Train rates the teams using the given games and fits the regressions
+// to the rating difference before each game.
+func (p *EloPredictor) Train(games []Game) {
+	sorted := slices.Clone(games)
+	sortGames(sorted)
+
+	var diffs, results, margins []float64
+	for _, g := range sorted {
+		if !g.Final() {
+			continue
+		}
+
+		diffs = append(diffs, p.ratingDiff(g))
+		margins = append(margins, float64(g.HomeScore-g.AwayScore))
+		switch {
+		case g.HomeScore > g.AwayScore:
+			results = append(results, 1)
+		case g.HomeScore < g.AwayScore:
+			results = append(results, 0)
+		default:
+			results = append(results, 0.5)
+		}
+
+		p.elo.Process([]Game{g})
+	}
+
+	if len(diffs) == 0 {
+		return
+	}
+
+	p.a, p.b = fitLogistic(diffs, results, p.a, p.b)
+	p.intercept, p.per = fitLinear(diffs, margins)
+}
+
+// Predict
+func (p *EloPredictor) Predict(g Game) Prediction {
+	diff := p.ratingDiff(g)
+
+	return Prediction{
+		Game:               g,
+		HomeWinProbability: 1 / (1 + math.Exp(-(p.a + p.b*diff))),
+		Spread:             p.intercept + p.per*diff,
+	}
+}
+
+// ratingDiff returns the home team's rating plus home advantage minus
+// the away team's rating, regressing both if the game is from a season
+// after the last one rated.
+func (p *EloPredictor) ratingDiff(g Game) float64 {
+	rating := func(teamID string) float64 {
+		r := p.elo.Rating(teamID)
+		if p.elo.year != 0 && g.Year > p.elo.year {
+			r += (p.elo.cfg.InitialRating - r) * p.elo.cfg.SeasonRegression
+		}
+		return r
+	}
+
+	return rating(g.HomeTeam.OfficialID) + p.elo.cfg.HomeAdvantage - rating(g.AwayTeam.OfficialID)
+}
+
+// fitLogistic fits y = 1 / (1 + e^-(a + bx)) to the given values using
+// Newton's method starting from the given coefficients.
+func fitLogistic(x, y []float64, a0, b0 float64) (float64, float64) {
+	a, b := a0, b0
+	for range 25 {
+		var ga, gb, haa, hab, hbb float64
+		for i := range x {
+			p := 1 / (1 + math.Exp(-(a + b*x[i])))
+			w := p * (1 - p)
+			ga += y[i] - p
+			gb += (y[i] - p) * x[i]
+			haa += w
+			hab += w * x[i]
+			hbb += w * x[i] * x[i]
+		}
+
+		det := haa*hbb - hab*hab
+		if det == 0 {
+			break
+		}
+
+		da := (hbb*ga - hab*gb) / det
+		db := (haa*gb - hab*ga) / det
+		a, b = a+da, b+db
+		if math.IsNaN(a) || math.IsNaN(b) || math.IsInf(a, 0) || math.IsInf(b, 0) {
+			return a0, b0
+		}
+		if math.Abs(da) < 1e-9 && math.Abs(db) < 1e-9 {
+			break
+		}
+	}
+
+	return a, b
+}
+
+// fitLinear fits y = a + bx to the given values using least squares.
+func fitLinear(x, y []float64) (float64, float64) {
+	mx, _ := meanStdDev(x)
+	my, _ := meanStdDev(y)
+
+	var sxy, sxx float64
+	for i := range x {
+		sxy += (x[i] - mx) * (y[i] - my)
+		sxx += (x[i] - mx) * (x[i] - mx)
+	}
+
+	if sxx == 0 {
+		return my, 0
+	}
+
+	b := sxy / sxx
+	return my - b*mx, b
+}
+
+// CalibrationBin compares the predicted and observed home win rates of
+// the games whose predictions fell within the bin.
+type CalibrationBin struct {
+	Lower     float64 `json:"lower"`
+	Upper     float64 `json:"upper"`
+	Games     int     `json:"games"`
+	Predicted float64 `json:"predicted"`
+	Observed  float64 `json:"observed"`
+}
+
+// BacktestSummary contains the accuracy of the predictions for a set of
+// games.
+type BacktestSummary struct {
+	Year        int              `json:"year"` // 0 for every season combined
+	Games       int              `json:"games"`
+	BrierScore  float64          `json:"brierScore"`
+	LogLoss     float64          `json:"logLoss"`
+	Accuracy    float64          `json:"accuracy"`
+	SpreadMAE   float64          `json:"spreadMAE"`
+	Calibration []CalibrationBin `json:"calibration"`
+}
+
+// BacktestResult
+type BacktestResult struct {
+	Seasons     []BacktestSummary `json:"seasons"`
+	Overall     BacktestSummary   `json:"overall"`
+	Predictions []Prediction      `json:"predictions"`
+}
+
+// Backtest walks forward through the seasons of the given games. Each
+// season after the first is predicted by a new model trained on every
+// completed game from the seasons before it. Ties count as half a win
+// when scoring predictions and predictions are grouped into the given
+// number of equal width calibration bins.
+func Backtest(newModel func() Predictor, games []Game, bins int) (*BacktestResult, error) {
+	if bins <= 0 {
+		return nil, errors.New("bins must be greater than 0")
+	}
+
+	var years []int
+	for _, g := range games {
+		if g.Final() && !slices.Contains(years, g.Year) {
+			years = append(years, g.Year)
+		}
+	}
+	slices.Sort(years)
+
+	if len(years) < 2 {
+		return nil, errors.New("at least two seasons of games are required")
+	}
+
+	var res BacktestResult
+	for _, year := range years[1:] {
+		var train, test []Game
+		for _, g := range games {
+			switch {
+			case g.Year < year:
+				train = append(train, g)
+			case g.Year == year && g.Final():
+				test = append(test, g)
+			}
+		}
+		sortGames(test)
+
+		model := newModel()
+		model.Train(train)
+
+		predictions := make([]Prediction, 0, len(test))
+		for _, g := range test {
+			predictions = append(predictions, model.Predict(g))
+		}
+
+		summary := summarize(predictions, bins)
+		summary.Year = year
+		res.Seasons = append(res.Seasons, summary)
+		res.Predictions = append(res.Predictions, predictions...)
+	}
+	res.Overall = summarize(res.Predictions, bins)
+
+	return &res, nil
+}
+
+// summarize scores the given predictions against the results of their
+// games.
+func summarize(predictions []Prediction, bins int) BacktestSummary {
+	s := BacktestSummary{
+		Games:       len(predictions),
+		Calibration: make([]CalibrationBin, bins),
+	}
+
+	for i := range s.Calibration {
+		s.Calibration[i].Lower = float64(i) / float64(bins)
+		s.Calibration[i].Upper = float64(i+1) / float64(bins)
+	}
+
+	if len(predictions) == 0 {
+		return s
+	}
+
+	const eps = 1e-15
+	for _, pr := range predictions {
+		g := pr.Game
+		margin := g.HomeScore - g.AwayScore
+
+		actual := 0.5
+		switch {
+		case margin > 0:
+			actual = 1
+		case margin < 0:
+			actual = 0
+		}
+
+		p := math.Min(math.Max(pr.HomeWinProbability, eps), 1-eps)
+		s.BrierScore += (p - actual) * (p - actual)
+		s.LogLoss -= actual*math.Log(p) + (1-actual)*math.Log(1-p)
+		s.SpreadMAE += math.Abs(pr.Spread - float64(margin))
+		if (p > 0.5 && actual == 1) || (p < 0.5 && actual == 0) {
+			s.Accuracy++
+		}
+
+		b := &s.Calibration[min(int(p*float64(bins)), bins-1)]
+		b.Games++
+		b.Predicted += p
+		b.Observed += actual
+	}
+
+	n := float64(len(predictions))
+	s.BrierScore /= n
+	s.LogLoss /= n
+	s.SpreadMAE /= n
+	s.Accuracy /= n
+
+	for i := range s.Calibration {
+		b := &s.Calibration[i]
+		if b.Games > 0 {
+			b.Predicted /= float64(b.Games)
+			b.Observed /= float64(b.Games)
+		}
+	}
+
+	return s
+}
+
+// Backtest retrieves every game for the given range of years and walks
+// forward through the seasons using models created by newModel.
+func (p *PLL) Backtest(ctx context.Context, fromYear, toYear int, newModel func() Predictor, bins int) (*BacktestResult, error) {
+	var games []Game
+	for year := fromYear; year <= toYear; year++ {
+		res, err := p.seasonGames(ctx, year)
+		if err != nil {
+			return nil, err
+		}
+		games = append(games, res...)
+	}
+
+	sortGames(games)
+
+	return Backtest(newModel, games, bins)
+}
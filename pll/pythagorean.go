@@ -0,0 +1,151 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package pll
+
+import (
+	"context"
+	"math"
+)
+
+const (
+	minPythagoreanExponent = 0.5
+	maxPythagoreanExponent = 10
+)
+
+// PythagoreanStanding is a standing along with the team's Pythagorean
+// expected winning percentage.
+type PythagoreanStanding struct {
+	Standing
+	Year           int     `json:"year"`
+	Exponent       float64 `json:"exponent"`
+	GamesPlayed    int     `json:"gamesPlayed"`
+	WinPct         float64 `json:"winPct"`
+	ExpectedWinPct float64 `json:"expectedWinPct"`
+	ExpectedWins   float64 `json:"expectedWins"`
+	Luck           float64 `json:"luck"` // actual minus expected winning percentage
+	ScoreDiffPG    float64 `json:"scoreDiffPG"`
+}
+
+// PythagoreanWinPct returns the expected winning percentage for a team
+// with the given scores for and against:
+//
+//	scores^exp / (scores^exp + scoresAgainst^exp)
+func PythagoreanWinPct(scores, scoresAgainst int, exponent float64) float64 {
+	sf := math.Pow(float64(scores), exponent)
+	sa := math.Pow(float64(scoresAgainst), exponent)
+	if sf+sa == 0 {
+		return 0.5
+	}
+
+	return sf / (sf + sa)
+}
+
+// FitPythagoreanExponent returns the exponent minimizing the squared
+// error between the actual and expected winning percentages of the
+// given standings.
+func FitPythagoreanExponent(standings []Standing) float64 {
+	sse := func(exp float64) float64 {
+		var sum float64
+		for _, s := range standings {
+			gp := s.Wins + s.Losses + s.Ties
+			if gp == 0 {
+				continue
+			}
+			d := winPct(s.Wins, s.Losses, s.Ties) - PythagoreanWinPct(s.Scores, s.ScoresAgainst, exp)
+			sum += d * d
+		}
+		return sum
+	}
+
+	// golden section search
+	phi := (math.Sqrt(5) - 1) / 2
+	a, b := float64(minPythagoreanExponent), float64(maxPythagoreanExponent)
+	c, d := b-phi*(b-a), a+phi*(b-a)
+	for b-a > 1e-4 {
+		if sse(c) < sse(d) {
+			b = d
+		} else {
+			a = c
+		}
+		c, d = b-phi*(b-a), a+phi*(b-a)
+	}
+
+	return (a + b) / 2
+}
+
+// Pythagorean computes the expected winning percentage, luck and score
+// differential per game of each of the given standings using the given
+// exponent.
+func Pythagorean(standings []Standing, year int, exponent float64) []PythagoreanStanding {
+	res := make([]PythagoreanStanding, 0, len(standings))
+	for _, s := range standings {
+		ps := PythagoreanStanding{
+			Standing:       s,
+			Year:           year,
+			Exponent:       exponent,
+			GamesPlayed:    s.Wins + s.Losses + s.Ties,
+			WinPct:         winPct(s.Wins, s.Losses, s.Ties),
+			ExpectedWinPct: PythagoreanWinPct(s.Scores, s.ScoresAgainst, exponent),
+		}
+		ps.ExpectedWins = ps.ExpectedWinPct * float64(ps.GamesPlayed)
+		ps.Luck = ps.WinPct - ps.ExpectedWinPct
+
+		if ps.GamesPlayed > 0 {
+			ps.ScoreDiffPG = float64(s.Scores-s.ScoresAgainst) / float64(ps.GamesPlayed)
+		}
+
+		res = append(res, ps)
+	}
+
+	return res
+}
+
+// PythagoreanStandings retrieves the regular season standings for the
+// given range of years, fits a single exponent across every season and
+// returns each standing with its Pythagorean expectation.
+func (p *PLL) PythagoreanStandings(ctx context.Context, fromYear, toYear int) ([]PythagoreanStanding, error) {
+	var all []Standing
+	byYear := make(map[int][]Standing)
+
+	for year := fromYear; year <= toYear; year++ {
+		res, err := p.Standings(ctx, year, false)
+		if err != nil {
+			return nil, err
+		}
+		byYear[year] = res.Standings
+		all = append(all, res.Standings...)
+	}
+
+	exponent := FitPythagoreanExponent(all)
+
+	var res []PythagoreanStanding
+	for year := fromYear; year <= toYear; year++ {
+		res = append(res, Pythagorean(byYear[year], year, exponent)...)
+	}
+
+	return res, nil
+}
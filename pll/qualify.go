@@ -0,0 +1,151 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package pll
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+)
+
+// Qualifier contains the minimum sample sizes a player must meet to be
+// ranked. MinGames applies to every stat while the remaining minimums
+// apply only to the stats computed from them:
+//
+//	MinShots    shotPct, twoPointShotPct, shotsOnGoalPct
+//	MinFaceoffs faceoffPct, faceoffWinsPG
+//	MinSaves    savePct, savesPG, saa, goalsAgainst,
+//	            twoPointGoalsAgainst, scoresAgainst
+//
+// Players must also have faced a shot on goal to be ranked by any of
+// the stats covered by MinSaves so skaters, who have none against them,
+// aren't ranked as goalies.
+type Qualifier struct {
+	MinGames    int `json:"minGames"`
+	MinShots    int `json:"minShots"`
+	MinFaceoffs int `json:"minFaceoffs"`
+	MinSaves    int `json:"minSaves"`
+}
+
+// Qualifies checks to see if the given stat line meets the qualifier
+// for the given stat.
+func (q Qualifier) Qualifies(s PlayerStats, stat string) bool {
+	if s.GamesPlayed < q.MinGames {
+		return false
+	}
+
+	switch stat {
+	case "shotPct", "twoPointShotPct", "shotsOnGoalPct":
+		return s.Shots >= q.MinShots
+	case "faceoffPct", "faceoffWinsPG":
+		return s.Faceoffs >= q.MinFaceoffs
+	case "savePct", "savesPG", "saa", "goalsAgainst", "twoPointGoalsAgainst", "scoresAgainst":
+		return s.Saves+s.GoalsAgainst > 0 && s.Saves >= q.MinSaves
+	}
+
+	return true
+}
+
+// QualifiedPlayerStats retrieves every player's stat line for the given
+// year and season segment and ranks the players meeting the qualifier
+// by each of the given stats, keeping the top limit players per stat.
+// Players are ranked locally, so the response has the same shape as
+// PlayerStats without small samples dominating rate stats.
+func (p *PLL) QualifiedPlayerStats(ctx context.Context, year, limit int, seasonSegment string, stats []string, q Qualifier) (*PlayerStatsResponse, error) {
+	if err := ValidStats(stats); err != nil {
+		return nil, errors.New("invalid stats")
+	}
+
+	players, err := p.PlayerSeasonStats(ctx, year, seasonSegment)
+	if err != nil {
+		return nil, err
+	}
+
+	var res PlayerStatsResponse
+	for _, stat := range stats {
+		res.PlayerStatLeaders = append(res.PlayerStatLeaders, rankPlayers(players.AllPlayers, stat, limit, q)...)
+	}
+
+	return &res, nil
+}
+
+// rankPlayers orders the players meeting the qualifier by the given
+// stat, keeping the top limit players.
+func rankPlayers(players []PlayerSeason, stat string, limit int, q Qualifier) []PlayerStatLeader {
+	type ranked struct {
+		ps    PlayerSeason
+		value float64
+	}
+
+	var rs []ranked
+	for _, ps := range players {
+		if !q.Qualifies(ps.Stats, stat) {
+			continue
+		}
+		v, _ := statValue(ps.Stats, stat)
+		rs = append(rs, ranked{ps, v})
+	}
+
+	asc := slices.Contains(lowerIsBetter, stat)
+	slices.SortStableFunc(rs, func(a, b ranked) int {
+		if asc {
+			return cmp.Compare(a.value, b.value)
+		}
+		return cmp.Compare(b.value, a.value)
+	})
+
+	if limit > 0 && len(rs) > limit {
+		rs = rs[:limit]
+	}
+
+	leaders := make([]PlayerStatLeader, 0, len(rs))
+	for i, r := range rs {
+		rank := i + 1
+		if i > 0 && r.value == rs[i-1].value {
+			rank = leaders[i-1].PlayerRank
+		}
+
+		leaders = append(leaders, PlayerStatLeader{
+			OfficialID: r.ps.OfficialID,
+			ProfileURL: r.ps.ProfileURL,
+			FirstName:  r.ps.FirstName,
+			LastName:   r.ps.LastName,
+			Position:   r.ps.Position,
+			StatType:   stat,
+			Slug:       r.ps.Slug,
+			StatValue:  strconv.FormatFloat(r.value, 'f', -1, 64),
+			PlayerRank: rank,
+			JerseyNum:  r.ps.JerseyNum,
+			TeamID:     r.ps.Team.OfficialID,
+			Year:       r.ps.Year,
+		})
+	}
+
+	return leaders
+}
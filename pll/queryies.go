@@ -79,3 +79,242 @@ const playerStatsQuery = `query($year: Int!, $seasonSegment: SeasonSegment, $sta
 	}
 }
 `
+
+// playerSeasonStatsQuery contains the GraphQL query to get every
+// player's complete stat line by year and season segment.
+const playerSeasonStatsQuery = `query($year: Int!, $segment: SeasonSegment) {
+	allPlayers(season: $year) {
+	officialId
+	profileUrl
+	firstName
+	lastName
+	slug
+	jerseyNum
+	handedness
+	position
+	positionName
+	team {
+		officialId
+		location
+		locationCode
+		urlLogo
+		fullName
+	}
+	stats(season: $year, segment: $segment) {
+		` + playerStatsFields + `
+	}
+	}
+}
+`
+
+// playerStatsFields contains the fields requested for a player's
+// stat line.
+const playerStatsFields = `gamesPlayed
+		points
+		goals
+		onePointGoals
+		twoPointGoals
+		scoringPoints
+		assists
+		shots
+		shotsOnGoal
+		twoPointShots
+		twoPointShotsOnGoal
+		shotPct
+		twoPointShotPct
+		shotsOnGoalPct
+		touches
+		totalPasses
+		groundBalls
+		turnovers
+		causedTurnovers
+		faceoffs
+		faceoffsWon
+		faceoffsLost
+		faceoffPct
+		faceoffViolations
+		saves
+		savePct
+		goalsAgainst
+		twoPointGoalsAgainst
+		scoresAgainst
+		saa
+		numPenalties
+		pim
+		powerPlayGoals
+		powerPlayShots
+		shortHandedGoals
+		shortHandedShots
+		goalieWins
+		goalieLosses
+		goalieTies
+		tof
+		pointsPG
+		onePointGoalsPG
+		assistsPG
+		shotsPG
+		touchesPG
+		faceoffWinsPG
+		savesPG
+		causedTurnoversPG
+		groundBallsPG`
+
+// teamSeasonStatsQuery contains the GraphQL query to get every team's
+// complete stat line by year and season segment.
+const teamSeasonStatsQuery = `query($year: Int!, $segment: SeasonSegment) {
+	allTeams(season: $year) {
+	officialId
+	locationCode
+	location
+	fullName
+	urlLogo
+	slogan
+	league
+	coaches {
+		name
+		coachType
+	}
+	stats(segment: $segment) {
+		` + teamStatsFields + `
+	}
+	}
+}
+`
+
+// teamStatsFields contains the fields requested for a team's stat
+// line.
+const teamStatsFields = `scores
+		faceoffPct
+		shotPct
+		twoPointShotPct
+		twoPointShotsOnGoalPct
+		clearPct
+		ridesPct
+		savePct
+		shortHandedPct
+		shortHandedGoalsAgainstPct
+		powerPlayGoalsAgainstPct
+		manDownPct
+		shotsOnGoalPct
+		onePointGoals
+		scoresAgainst
+		saa
+		powerPlayPct
+		gamesPlayed
+		goals
+		twoPointGoals
+		assists
+		groundBalls
+		turnovers
+		causedTurnovers
+		faceoffsWon
+		faceoffsLost
+		faceoffs
+		shots
+		twoPointShots
+		twoPointShotsOnGoal
+		goalsAgainst
+		twoPointGoalsAgainst
+		numPenalties
+		pim
+		clears
+		clearAttempts
+		rides
+		rideAttempts
+		saves
+		offsides
+		shotClockExpirations
+		powerPlayGoals
+		powerPlayShots
+		shortHandedGoals
+		shortHandedShots
+		shortHandedShotsAgainst
+		shortHandedGoalsAgainst
+		powerPlayGoalsAgainst
+		powerPlayShotsAgainst
+		timesManUp
+		timesShortHanded
+		shotsOnGoal
+		scoresPG
+		shotsPG
+		totalPasses
+		touches`
+
+// gameFields contains the fields requested for a game.
+const gameFields = `id
+		slugname
+		year
+		week
+		startTime
+		seasonSegment
+		eventStatus
+		homeTeam {
+			officialId
+			location
+			locationCode
+			urlLogo
+			fullName
+		}
+		awayTeam {
+			officialId
+			location
+			locationCode
+			urlLogo
+			fullName
+		}
+		homeScore
+		visitorScore`
+
+// playerGameLogsQuery contains the GraphQL query to get a player's
+// stat line for each game played by year and season segment.
+const playerGameLogsQuery = `query($id: ID!, $year: Int!, $segment: SeasonSegment) {
+	player(id: $id) {
+	gameLogs(season: $year, segment: $segment) {
+		event {
+		` + gameFields + `
+		}
+		teamId
+		stats {
+		` + playerStatsFields + `
+		}
+	}
+	}
+}
+`
+
+// gamesQuery contains the GraphQL query to get every game by year,
+// including the Championship Series.
+const gamesQuery = `query($year: Int!) {
+	seasonEvents(season: $year, includeCS: true) {
+		` + gameFields + `
+	}
+}
+`
+
+// boxScoreQuery contains the GraphQL query to get the team and player
+// stat lines of a single game.
+const boxScoreQuery = `query($id: ID!) {
+	event(id: $id) {
+		` + gameFields + `
+		teams {
+			officialId
+			stats {
+			` + teamStatsFields + `
+			}
+		}
+		players {
+			officialId
+			profileUrl
+			firstName
+			lastName
+			slug
+			jerseyNum
+			position
+			teamId
+			stats {
+			` + playerStatsFields + `
+			}
+		}
+	}
+}
+`
@@ -0,0 +1,231 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package pll
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"slices"
+)
+
+// DistanceMetric returns the distance between two stat vectors of the
+// same length.
+type DistanceMetric func(a, b []float64) float64
+
+// EuclideanDistance
+func EuclideanDistance(a, b []float64) float64 {
+	var sum float64
+	for i := range a {
+		sum += (a[i] - b[i]) * (a[i] - b[i])
+	}
+
+	return math.Sqrt(sum)
+}
+
+// ManhattanDistance
+func ManhattanDistance(a, b []float64) float64 {
+	var sum float64
+	for i := range a {
+		sum += math.Abs(a[i] - b[i])
+	}
+
+	return sum
+}
+
+// CosineDistance returns 1 minus the cosine similarity of the vectors.
+func CosineDistance(a, b []float64) float64 {
+	var dot, na, nb float64
+	for i := range a {
+		dot += a[i] * b[i]
+		na += a[i] * a[i]
+		nb += b[i] * b[i]
+	}
+
+	if na == 0 || nb == 0 {
+		return 1
+	}
+
+	return 1 - dot/(math.Sqrt(na)*math.Sqrt(nb))
+}
+
+// SimilarityOptions
+type SimilarityOptions struct {
+	K        int            // number of players returned, defaults to 10
+	Position string         // only return players of this position when set
+	MinGames int            // minimum games played to be considered
+	Metric   DistanceMetric // defaults to EuclideanDistance
+	Stats    []string       // defaults to PlayerStatistics
+}
+
+// SimilarPlayer is a player season along with its distance from the
+// season being compared.
+type SimilarPlayer struct {
+	PlayerSeason
+	Distance float64 `json:"distance"`
+}
+
+// SimilarPlayers returns the K player seasons from the given pool
+// nearest to the target season, closest first. Counting stats are
+// converted to per game values and every stat is standardized to a
+// z-score across the pool before distances are measured so that no
+// stat dominates because of its scale. The target season itself is
+// never returned.
+func SimilarPlayers(target PlayerSeason, pool []PlayerSeason, opts SimilarityOptions) ([]SimilarPlayer, error) {
+	if opts.K <= 0 {
+		opts.K = 10
+	}
+
+	if opts.Metric == nil {
+		opts.Metric = EuclideanDistance
+	}
+
+	if opts.Stats == nil {
+		opts.Stats = PlayerStatistics
+	}
+
+	if err := ValidStats(opts.Stats); err != nil {
+		return nil, err
+	}
+
+	var candidates []PlayerSeason
+	for _, ps := range pool {
+		if ps.OfficialID == target.OfficialID && ps.Year == target.Year && ps.SeasonSegment == target.SeasonSegment {
+			continue
+		}
+		if opts.Position != "" && ps.Position != opts.Position {
+			continue
+		}
+		if ps.Stats.GamesPlayed < opts.MinGames {
+			continue
+		}
+		candidates = append(candidates, ps)
+	}
+
+	vectors := make([][]float64, 0, len(candidates)+1)
+	vectors = append(vectors, perGameVector(target.Stats, opts.Stats))
+	for _, ps := range candidates {
+		vectors = append(vectors, perGameVector(ps.Stats, opts.Stats))
+	}
+	standardize(vectors)
+
+	res := make([]SimilarPlayer, 0, len(candidates))
+	for i, ps := range candidates {
+		res = append(res, SimilarPlayer{
+			PlayerSeason: ps,
+			Distance:     opts.Metric(vectors[0], vectors[i+1]),
+		})
+	}
+
+	slices.SortStableFunc(res, func(a, b SimilarPlayer) int {
+		return cmp.Compare(a.Distance, b.Distance)
+	})
+
+	if len(res) > opts.K {
+		res = res[:opts.K]
+	}
+
+	return res, nil
+}
+
+// perGameVector returns the given stats as a vector with counting stats
+// divided by games played.
+func perGameVector(s PlayerStats, stats []string) []float64 {
+	rt := reflect.TypeOf(s)
+	vec := make([]float64, 0, len(stats))
+
+	for _, stat := range stats {
+		v, _ := statValue(s, stat)
+		for i := 0; i < rt.NumField(); i++ {
+			f := rt.Field(i)
+			if jsonName(f) == stat && f.Type.Kind() == reflect.Int && s.GamesPlayed > 0 {
+				v /= float64(s.GamesPlayed)
+			}
+		}
+		vec = append(vec, v)
+	}
+
+	return vec
+}
+
+// standardize converts each dimension of the given vectors to a
+// z-score in place.
+func standardize(vectors [][]float64) {
+	if len(vectors) == 0 {
+		return
+	}
+
+	for d := range vectors[0] {
+		values := make([]float64, 0, len(vectors))
+		for _, v := range vectors {
+			values = append(values, v[d])
+		}
+
+		mean, sd := meanStdDev(values)
+		for _, v := range vectors {
+			if sd == 0 {
+				v[d] = 0
+				continue
+			}
+			v[d] = (v[d] - mean) / sd
+		}
+	}
+}
+
+// SimilarPlayers retrieves every player's stat line for the given
+// season segment across the given range of years and returns the
+// seasons nearest to the given player's season.
+func (p *PLL) SimilarPlayers(ctx context.Context, officialID string, year int, seasonSegment string, fromYear, toYear int, opts SimilarityOptions) ([]SimilarPlayer, error) {
+	seasons := make(map[int][]PlayerSeason)
+	for y := min(year, fromYear); y <= max(year, toYear); y++ {
+		if y != year && (y < fromYear || y > toYear) {
+			continue
+		}
+
+		res, err := p.PlayerSeasonStats(ctx, y, seasonSegment)
+		if err != nil {
+			return nil, err
+		}
+		seasons[y] = res.AllPlayers
+	}
+
+	i := slices.IndexFunc(seasons[year], func(ps PlayerSeason) bool {
+		return ps.OfficialID == officialID
+	})
+	if i == -1 {
+		return nil, errors.New("player not found: " + officialID)
+	}
+
+	var pool []PlayerSeason
+	for y := fromYear; y <= toYear; y++ {
+		pool = append(pool, seasons[y]...)
+	}
+
+	return SimilarPlayers(seasons[year][i], pool, opts)
+}
@@ -0,0 +1,284 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package pll
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"slices"
+)
+
+// OutcomeModel determines the result of a game that has not been
+// played yet.
+type OutcomeModel interface {
+	Outcome(g Game, rng *rand.Rand) (homeScore, awayScore int)
+}
+
+// ProbabilityModel is an OutcomeModel that decides each game using the
+// probability of the home team winning. Winners are credited with a
+// one score margin.
+type ProbabilityModel struct {
+	HomeWinProbability func(g Game) float64
+}
+
+// Outcome
+func (m ProbabilityModel) Outcome(g Game, rng *rand.Rand) (int, int) {
+	if rng.Float64() < m.HomeWinProbability(g) {
+		return 1, 0
+	}
+
+	return 0, 1
+}
+
+// CoinFlipModel returns a model giving both teams an equal chance of
+// winning every game.
+func CoinFlipModel() OutcomeModel {
+	return ProbabilityModel{
+		HomeWinProbability: func(Game) float64 { return 0.5 },
+	}
+}
+
+// Log5Model returns a model deciding each game using the log5 estimate
+// calculated from each team's winning percentage in the given table.
+func Log5Model(t *SeasonTable) OutcomeModel {
+	return ProbabilityModel{
+		HomeWinProbability: func(g Game) float64 {
+			var a, b float64
+			if r, ok := t.Records[g.HomeTeam.OfficialID]; ok {
+				a = r.WinPct()
+			}
+			if r, ok := t.Records[g.AwayTeam.OfficialID]; ok {
+				b = r.WinPct()
+			}
+
+			d := a + b - 2*a*b
+			if d == 0 {
+				return 0.5
+			}
+
+			return (a - a*b) / d
+		},
+	}
+}
+
+// SimulationConfig
+type SimulationConfig struct {
+	Iterations   int          // number of times the remaining games are played
+	PlayoffTeams int          // number of seeds that qualify for the playoffs
+	Model        OutcomeModel // defaults to Log5Model of the current table
+	Tiebreakers  []Tiebreaker // defaults to DefaultTiebreakers
+	Seed         uint64       // seed for the random number generator
+}
+
+// TeamOdds contains a team's simulated playoff odds along with its
+// clinching and elimination status.
+type TeamOdds struct {
+	Team                   Team       `json:"team"`
+	Record                 TeamRecord `json:"record"`
+	SeedProbabilities      []float64  `json:"seedProbabilities"` // index 0 is the first seed
+	PlayoffProbability     float64    `json:"playoffProbability"`
+	EliminationProbability float64    `json:"eliminationProbability"`
+	MagicNumber            int        `json:"magicNumber"`
+	EliminationNumber      int        `json:"eliminationNumber"` // -1 when the team cannot be eliminated
+	Clinched               bool       `json:"clinched"`
+	Eliminated             bool       `json:"eliminated"`
+}
+
+// SimulationResult
+type SimulationResult struct {
+	Iterations int        `json:"iterations"`
+	Remaining  []Game     `json:"remaining"`
+	Teams      []TeamOdds `json:"teams"`
+}
+
+// Simulate plays out the remaining regular season games the configured
+// number of times, seeds every team after each iteration and reports
+// how often each team finished in each seed.
+//
+// Magic and elimination numbers are computed from wins alone and
+// require a team to finish strictly ahead, so tiebreakers never
+// decide a clinch. A magic number is the combination of the team's
+// wins and the losses of the teams it must finish ahead of that
+// guarantees a playoff berth. An elimination number is the combination
+// of the team's losses and the wins of the teams it must catch that
+// eliminates it.
+func Simulate(games []Game, standings []Standing, cfg SimulationConfig) (*SimulationResult, error) {
+	if cfg.Iterations <= 0 {
+		return nil, errors.New("iterations must be greater than 0")
+	}
+
+	table := NewSeasonTable(games, standings)
+
+	// teams without a standing or a completed game are added so every
+	// team that can be seeded is counted
+	var remaining []Game
+	for _, g := range games {
+		if g.SeasonSegment == "regular" && !g.Final() {
+			remaining = append(remaining, g)
+			table.addTeam(g.HomeTeam)
+			table.addTeam(g.AwayTeam)
+		}
+	}
+
+	if cfg.PlayoffTeams <= 0 || cfg.PlayoffTeams > len(table.Records) {
+		return nil, errors.New("invalid number of playoff teams")
+	}
+
+	if cfg.Model == nil {
+		cfg.Model = Log5Model(table)
+	}
+
+	res := SimulationResult{
+		Iterations: cfg.Iterations,
+		Remaining:  remaining,
+	}
+
+	counts := make(map[string][]int, len(table.Records))
+	for id := range table.Records {
+		counts[id] = make([]int, len(table.Records))
+	}
+
+	rng := rand.New(rand.NewPCG(cfg.Seed, cfg.Seed))
+	for range cfg.Iterations {
+		sim := table.clone()
+		for _, g := range remaining {
+			g.HomeScore, g.AwayScore = cfg.Model.Outcome(g, rng)
+			sim.AddGame(g)
+		}
+
+		for _, s := range sim.Seed(cfg.Tiebreakers) {
+			counts[s.Team.OfficialID][s.Seed-1]++
+		}
+	}
+
+	magic, elimination := clinchNumbers(table, remaining, cfg.PlayoffTeams)
+
+	for _, s := range table.Seed(cfg.Tiebreakers) {
+		id := s.Team.OfficialID
+		odds := TeamOdds{
+			Team:              s.Team,
+			Record:            s.Record,
+			SeedProbabilities: make([]float64, len(counts[id])),
+			MagicNumber:       magic[id],
+			EliminationNumber: elimination[id],
+			Clinched:          magic[id] == 0,
+			Eliminated:        elimination[id] == 0,
+		}
+
+		for i, n := range counts[id] {
+			odds.SeedProbabilities[i] = float64(n) / float64(cfg.Iterations)
+			if i < cfg.PlayoffTeams {
+				odds.PlayoffProbability += odds.SeedProbabilities[i]
+			}
+		}
+		odds.EliminationProbability = 1 - odds.PlayoffProbability
+
+		res.Teams = append(res.Teams, odds)
+	}
+
+	return &res, nil
+}
+
+// clone returns a copy of the table that can have games added to it
+// without modifying the original.
+func (t *SeasonTable) clone() *SeasonTable {
+	c := SeasonTable{
+		Teams:       t.Teams,
+		Records:     make(map[string]*TeamRecord, len(t.Records)),
+		Conferences: t.Conferences,
+		Games:       slices.Clip(t.Games),
+		seeds:       t.seeds,
+	}
+
+	for id, r := range t.Records {
+		rc := *r
+		c.Records[id] = &rc
+	}
+
+	return &c
+}
+
+// clinchNumbers computes the magic and elimination numbers of every
+// team in the table, clamped at 0.
+func clinchNumbers(t *SeasonTable, remaining []Game, playoffTeams int) (map[string]int, map[string]int) {
+	left := make(map[string]int, len(t.Records))
+	for _, g := range remaining {
+		left[g.HomeTeam.OfficialID]++
+		left[g.AwayTeam.OfficialID]++
+	}
+
+	magic := make(map[string]int, len(t.Records))
+	elimination := make(map[string]int, len(t.Records))
+
+	for id, r := range t.Records {
+		var toClinch, toEliminate []int
+		for oid, o := range t.Records {
+			if oid == id {
+				continue
+			}
+			// wins by the team or losses by the other team needed to
+			// finish ahead of every possible outcome for the other team
+			toClinch = append(toClinch, o.Wins+left[oid]-r.Wins+1)
+			// wins by the other team or losses by the team needed for
+			// the other team to finish ahead of the team's best outcome
+			toEliminate = append(toEliminate, r.Wins+left[id]-o.Wins+1)
+		}
+		slices.Sort(toClinch)
+		slices.Sort(toEliminate)
+
+		// the team must finish ahead of every team outside the playoff
+		// spots to clinch and is eliminated once enough teams are
+		// guaranteed to finish ahead of it to fill the playoff spots.
+		if n := len(toClinch) - playoffTeams; n >= 0 {
+			magic[id] = max(toClinch[n], 0)
+		}
+		if playoffTeams <= len(toEliminate) {
+			elimination[id] = max(toEliminate[playoffTeams-1], 0)
+		} else {
+			elimination[id] = -1
+		}
+	}
+
+	return magic, elimination
+}
+
+// PlayoffOdds retrieves the games and standings for the given year and
+// simulates the remainder of the regular season.
+func (p *PLL) PlayoffOdds(ctx context.Context, year int, cfg SimulationConfig) (*SimulationResult, error) {
+	games, err := p.Games(ctx, year)
+	if err != nil {
+		return nil, err
+	}
+
+	standings, err := p.Standings(ctx, year, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return Simulate(games.SeasonEvents, standings.Standings, cfg)
+}
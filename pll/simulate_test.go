@@ -0,0 +1,169 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package pll
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// scheduled returns a regular season game that has not been played.
+func scheduled(home, away string) Game {
+	return Game{
+		SeasonSegment: "regular",
+		HomeTeam:      Team{OfficialID: home},
+		AwayTeam:      Team{OfficialID: away},
+	}
+}
+
+// simulationGames returns a season in which A has clinched a playoff
+// spot and D has been eliminated from the top two.
+//
+//	A 4-0, B 2-1, C 1-1, D 0-5; remaining B-C and C-D
+func simulationGames() []Game {
+	return []Game{
+		final("A", "B", 10, 5),
+		final("A", "C", 10, 5),
+		final("A", "D", 10, 5),
+		final("D", "A", 5, 10),
+		final("B", "D", 10, 5),
+		final("D", "B", 5, 10),
+		final("C", "D", 10, 5),
+		scheduled("B", "C"),
+		scheduled("C", "D"),
+	}
+}
+
+// oddsByTeam indexes the simulated odds by team ID.
+func oddsByTeam(res *SimulationResult) map[string]TeamOdds {
+	odds := make(map[string]TeamOdds, len(res.Teams))
+	for _, o := range res.Teams {
+		odds[o.Team.OfficialID] = o
+	}
+
+	return odds
+}
+
+func TestSimulateClinchAndElimination(t *testing.T) {
+	cfg := SimulationConfig{Iterations: 500, PlayoffTeams: 2, Seed: 42}
+
+	res, err := Simulate(simulationGames(), nil, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	odds := oddsByTeam(res)
+
+	tests := []struct {
+		team        string
+		magic       int
+		elimination int
+		clinched    bool
+		eliminated  bool
+	}{
+		{"A", 0, 4, true, false},
+		{"B", 2, 3, false, false},
+		{"C", 3, 2, false, false},
+		{"D", 4, 0, false, true},
+	}
+	for _, tt := range tests {
+		o := odds[tt.team]
+		if o.MagicNumber != tt.magic || o.EliminationNumber != tt.elimination {
+			t.Errorf("%s: magic %d elimination %d, want %d %d", tt.team, o.MagicNumber, o.EliminationNumber, tt.magic, tt.elimination)
+		}
+		if o.Clinched != tt.clinched || o.Eliminated != tt.eliminated {
+			t.Errorf("%s: clinched %t eliminated %t, want %t %t", tt.team, o.Clinched, o.Eliminated, tt.clinched, tt.eliminated)
+		}
+
+		var total float64
+		for _, p := range o.SeedProbabilities {
+			total += p
+		}
+		if math.Abs(total-1) > 1e-9 {
+			t.Errorf("%s: seed probabilities sum to %v", tt.team, total)
+		}
+	}
+
+	if p := odds["A"].SeedProbabilities[0]; p != 1 {
+		t.Errorf("A: first seed probability %v, want 1", p)
+	}
+	if p := odds["D"].EliminationProbability; p != 1 {
+		t.Errorf("D: elimination probability %v, want 1", p)
+	}
+
+	again, err := Simulate(simulationGames(), nil, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(res, again) {
+		t.Error("simulations with the same seed differ")
+	}
+}
+
+func TestSimulateEveryTeamQualifies(t *testing.T) {
+	res, err := Simulate(simulationGames(), nil, SimulationConfig{Iterations: 100, PlayoffTeams: 4, Seed: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, o := range res.Teams {
+		if !o.Clinched || o.MagicNumber != 0 {
+			t.Errorf("%s: clinched %t magic %d, want clinched", o.Team.OfficialID, o.Clinched, o.MagicNumber)
+		}
+		if o.Eliminated || o.EliminationNumber != -1 {
+			t.Errorf("%s: eliminated %t elimination %d, want -1", o.Team.OfficialID, o.Eliminated, o.EliminationNumber)
+		}
+		if math.Abs(o.PlayoffProbability-1) > 1e-9 {
+			t.Errorf("%s: playoff probability %v, want 1", o.Team.OfficialID, o.PlayoffProbability)
+		}
+	}
+
+	if _, err := Simulate(simulationGames(), nil, SimulationConfig{Iterations: 100, PlayoffTeams: 5}); err == nil {
+		t.Error("expected an error for more playoff teams than teams")
+	}
+}
+
+func TestSimulateTeamOnlyInRemainingGames(t *testing.T) {
+	games := append(simulationGames(), scheduled("E", "A"), scheduled("B", "E"))
+
+	res, err := Simulate(games, nil, SimulationConfig{Iterations: 200, PlayoffTeams: 5, Seed: 7, Model: CoinFlipModel()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	odds := oddsByTeam(res)
+	e, ok := odds["E"]
+	if !ok {
+		t.Fatal("E missing from results")
+	}
+	if len(e.SeedProbabilities) != 5 {
+		t.Errorf("E: %d seed probabilities, want 5", len(e.SeedProbabilities))
+	}
+	if math.Abs(e.PlayoffProbability-1) > 1e-9 {
+		t.Errorf("E: playoff probability %v, want 1", e.PlayoffProbability)
+	}
+}
@@ -0,0 +1,196 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package pll
+
+import (
+	"context"
+	"slices"
+)
+
+// ScheduleStrength contains the strength of a set of games from the
+// perspective of a single team.
+type ScheduleStrength struct {
+	Games                  int     `json:"games"`
+	OpponentWinPct         float64 `json:"opponentWinPct"`
+	OpponentOpponentWinPct float64 `json:"opponentOpponentWinPct"`
+	SOS                    float64 `json:"sos"` // (2 * opponent win pct + opponents' opponent win pct) / 3
+	OpponentRating         float64 `json:"opponentRating"`
+}
+
+// StrengthOfSchedule contains a team's played and remaining strength of
+// schedule for a season segment.
+type StrengthOfSchedule struct {
+	Team          Team             `json:"team"`
+	SeasonSegment string           `json:"seasonSegment"`
+	Played        ScheduleStrength `json:"played"`
+	Remaining     ScheduleStrength `json:"remaining"`
+}
+
+// ScheduleStrengths computes the played and remaining strength of
+// schedule of every team for the given season segment. Records are
+// computed from the completed games of the segment and each opponent's
+// winning percentage excludes its games against the team being rated.
+// Standings supply teams that have not yet played. Ratings, such as
+// those returned by Elo.Ratings, are optional and used for the average
+// opponent rating. Opponents without a rating are left out of the
+// average, which is 0 when no opponent is rated.
+func ScheduleStrengths(games []Game, standings []Standing, seasonSegment string, ratings map[string]float64) []StrengthOfSchedule {
+	var played, remaining []Game
+	for _, g := range games {
+		if g.SeasonSegment != seasonSegment {
+			continue
+		}
+		if g.Final() {
+			played = append(played, g)
+		} else {
+			remaining = append(remaining, g)
+		}
+	}
+
+	table := NewSeasonTable(nil, standings)
+	for _, g := range played {
+		table.AddGame(g)
+	}
+	for _, g := range remaining {
+		table.addTeam(g.HomeTeam)
+		table.addTeam(g.AwayTeam)
+	}
+
+	teams := make([]string, 0, len(table.Teams))
+	for id := range table.Teams {
+		teams = append(teams, id)
+	}
+	slices.Sort(teams)
+
+	owp := make(map[string]float64, len(teams))
+	for _, id := range teams {
+		owp[id] = table.opponentWinPct(id, opponents(played, id))
+	}
+
+	res := make([]StrengthOfSchedule, 0, len(teams))
+	for _, id := range teams {
+		res = append(res, StrengthOfSchedule{
+			Team:          table.Teams[id],
+			SeasonSegment: seasonSegment,
+			Played:        table.scheduleStrength(id, opponents(played, id), owp, ratings),
+			Remaining:     table.scheduleStrength(id, opponents(remaining, id), owp, ratings),
+		})
+	}
+
+	return res
+}
+
+// opponents returns the opponent of the given team in each of the given
+// games it played in.
+func opponents(games []Game, teamID string) []string {
+	var opps []string
+	for _, g := range games {
+		switch teamID {
+		case g.HomeTeam.OfficialID:
+			opps = append(opps, g.AwayTeam.OfficialID)
+		case g.AwayTeam.OfficialID:
+			opps = append(opps, g.HomeTeam.OfficialID)
+		}
+	}
+
+	return opps
+}
+
+// opponentWinPct returns the average winning percentage of the given
+// opponents excluding their games against the given team.
+func (t *SeasonTable) opponentWinPct(teamID string, opps []string) float64 {
+	if len(opps) == 0 {
+		return 0
+	}
+
+	var sum float64
+	for _, opp := range opps {
+		r := *t.Records[opp]
+		vs := t.headToHead([]string{opp, teamID})[opp]
+		r.Wins -= vs.Wins
+		r.Losses -= vs.Losses
+		r.Ties -= vs.Ties
+		sum += r.WinPct()
+	}
+
+	return sum / float64(len(opps))
+}
+
+// scheduleStrength computes the strength of the given team's schedule
+// against the given opponents.
+func (t *SeasonTable) scheduleStrength(teamID string, opps []string, owp map[string]float64, ratings map[string]float64) ScheduleStrength {
+	ss := ScheduleStrength{
+		Games:          len(opps),
+		OpponentWinPct: t.opponentWinPct(teamID, opps),
+	}
+	if len(opps) == 0 {
+		return ss
+	}
+
+	var rated int
+	for _, opp := range opps {
+		ss.OpponentOpponentWinPct += owp[opp]
+		if r, ok := ratings[opp]; ok {
+			ss.OpponentRating += r
+			rated++
+		}
+	}
+	ss.OpponentOpponentWinPct /= float64(len(opps))
+	if rated > 0 {
+		ss.OpponentRating /= float64(rated)
+	}
+	ss.SOS = (2*ss.OpponentWinPct + ss.OpponentOpponentWinPct) / 3
+
+	return ss
+}
+
+// StrengthOfSchedule retrieves the games and standings for the given
+// year and computes every team's strength of schedule for both the
+// regular season and the Championship Series. Opponent ratings are
+// computed with Elo using the given configuration and the year's games.
+func (p *PLL) StrengthOfSchedule(ctx context.Context, year int, cfg EloConfig) ([]StrengthOfSchedule, error) {
+	games, err := p.Games(ctx, year)
+	if err != nil {
+		return nil, err
+	}
+
+	standings, err := p.Standings(ctx, year, false)
+	if err != nil {
+		return nil, err
+	}
+
+	elo := NewElo(cfg)
+	elo.Process(games.SeasonEvents)
+	ratings := elo.Ratings()
+
+	var res []StrengthOfSchedule
+	for _, segment := range []string{"regular", "champSeries"} {
+		res = append(res, ScheduleStrengths(games.SeasonEvents, standings.Standings, segment, ratings)...)
+	}
+
+	return res, nil
+}
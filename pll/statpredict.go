@@ -0,0 +1,374 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package pll
+
+import (
+	"context"
+	"math"
+)
+
+// statFeatures contains the names of the stat line metrics used by the
+// TeamStatsPredictor in the order returned by teamFeatures.
+var statFeatures = []string{
+	"offensiveEfficiency",
+	"defensiveEfficiency",
+	"turnoverRate",
+	"shootingEfficiency",
+	"faceoffPct",
+	"savePct",
+	"clearPct",
+}
+
+// teamFeatures returns the metrics of the given stat line used as
+// features by the TeamStatsPredictor.
+func teamFeatures(s TeamStats) []float64 {
+	te := NewTeamEfficiency(s, nil)
+
+	return []float64{
+		te.OffensiveEfficiency,
+		te.DefensiveEfficiency,
+		te.TurnoverRate,
+		te.ShootingEfficiency,
+		s.FaceoffPct,
+		s.SavePct,
+		te.ClearPct,
+	}
+}
+
+// statRidge is the ridge penalty applied to the feature coefficients of
+// the TeamStatsPredictor's regressions. The training sets are small so
+// the penalty keeps correlated metrics from producing large offsetting
+// coefficients.
+const statRidge = 1.0
+
+// TeamStatsPredictor predicts games using the differences between the
+// teams' regular season stat lines from the year before the game. A
+// logistic regression of results and a linear regression of margins
+// on the standardized home minus away differences of each metric in
+// statFeatures are fitted to the training games; the intercepts
+// capture home advantage. Teams without a stat line for the prior year,
+// such as expansion teams, are given the league average.
+type TeamStatsPredictor struct {
+	features map[int]map[string][]float64 // year, team ID, features
+
+	mean, std []float64
+
+	// coefficients of the logistic regression for the home win
+	// probability and the linear regression for the spread, intercept
+	// first
+	logit, linear []float64
+}
+
+// NewTeamStatsPredictor creates a new value of TeamStatsPredictor using
+// the given team stat lines. Only regular season stat lines are used.
+func NewTeamStatsPredictor(seasons []TeamSeason) *TeamStatsPredictor {
+	p := TeamStatsPredictor{
+		features: make(map[int]map[string][]float64),
+	}
+
+	for _, ts := range seasons {
+		if ts.SeasonSegment != "regular" || ts.Stats.GamesPlayed == 0 {
+			continue
+		}
+		if p.features[ts.Year] == nil {
+			p.features[ts.Year] = make(map[string][]float64)
+		}
+		p.features[ts.Year][ts.OfficialID] = teamFeatures(ts.Stats)
+	}
+
+	return &p
+}
+
+// gameFeatures returns the differences between the home and away
+// teams' features from the year before the game or false if no stat
+// lines are available for that year.
+func (p *TeamStatsPredictor) gameFeatures(g Game) ([]float64, bool) {
+	prior := p.features[g.Year-1]
+	if len(prior) == 0 {
+		return nil, false
+	}
+
+	average := make([]float64, len(statFeatures))
+	for _, f := range prior {
+		for i, v := range f {
+			average[i] += v / float64(len(prior))
+		}
+	}
+
+	team := func(teamID string) []float64 {
+		if f, ok := prior[teamID]; ok {
+			return f
+		}
+		return average
+	}
+
+	home, away := team(g.HomeTeam.OfficialID), team(g.AwayTeam.OfficialID)
+	diff := make([]float64, len(statFeatures))
+	for i := range diff {
+		diff[i] = home[i] - away[i]
+	}
+
+	return diff, true
+}
+
+// standardize scales the given features by the means and standard
+// deviations of the training features.
+func (p *TeamStatsPredictor) standardize(f []float64) []float64 {
+	z := make([]float64, len(f))
+	for i, v := range f {
+		z[i] = (v - p.mean[i]) / p.std[i]
+	}
+
+	return z
+}
+
+// Train fits the regressions to the completed games that have stat
+// lines for the year before them.
+func (p *TeamStatsPredictor) Train(games []Game) {
+	var x [][]float64
+	var results, margins []float64
+	for _, g := range games {
+		if !g.Final() {
+			continue
+		}
+
+		f, ok := p.gameFeatures(g)
+		if !ok {
+			continue
+		}
+
+		x = append(x, f)
+		margins = append(margins, float64(g.HomeScore-g.AwayScore))
+		switch {
+		case g.HomeScore > g.AwayScore:
+			results = append(results, 1)
+		case g.HomeScore < g.AwayScore:
+			results = append(results, 0)
+		default:
+			results = append(results, 0.5)
+		}
+	}
+
+	if len(x) == 0 {
+		return
+	}
+
+	p.mean = make([]float64, len(statFeatures))
+	p.std = make([]float64, len(statFeatures))
+	for i := range statFeatures {
+		column := make([]float64, len(x))
+		for j := range x {
+			column[j] = x[j][i]
+		}
+		p.mean[i], p.std[i] = meanStdDev(column)
+		if p.std[i] == 0 {
+			p.std[i] = 1
+		}
+	}
+
+	for i := range x {
+		x[i] = p.standardize(x[i])
+	}
+
+	p.logit = fitLogisticRidge(x, results, statRidge)
+	p.linear = fitLinearRidge(x, margins, statRidge)
+}
+
+// Predict
+func (p *TeamStatsPredictor) Predict(g Game) Prediction {
+	pr := Prediction{
+		Game:               g,
+		HomeWinProbability: 0.5,
+	}
+	if p.logit == nil {
+		return pr
+	}
+
+	z := make([]float64, len(statFeatures))
+	if f, ok := p.gameFeatures(g); ok {
+		z = p.standardize(f)
+	}
+
+	pr.HomeWinProbability = 1 / (1 + math.Exp(-dot(p.logit, z)))
+	pr.Spread = dot(p.linear, z)
+
+	return pr
+}
+
+// dot returns the intercept, the first coefficient, plus the dot
+// product of the remaining coefficients and the given features.
+func dot(coef, x []float64) float64 {
+	v := coef[0]
+	for i, xi := range x {
+		v += coef[i+1] * xi
+	}
+
+	return v
+}
+
+// fitLogisticRidge fits y = 1 / (1 + e^-(b0 + b·x)) to the given values
+// using Newton's method with a ridge penalty on every coefficient but
+// the intercept.
+func fitLogisticRidge(x [][]float64, y []float64, lambda float64) []float64 {
+	k := len(x[0]) + 1
+	coef := make([]float64, k)
+
+	for range 25 {
+		grad := make([]float64, k)
+		hess := make([][]float64, k)
+		for i := range hess {
+			hess[i] = make([]float64, k)
+		}
+
+		for i := range x {
+			row := append([]float64{1}, x[i]...)
+			p := 1 / (1 + math.Exp(-dot(coef, x[i])))
+			w := p * (1 - p)
+			for a := range row {
+				grad[a] += (y[i] - p) * row[a]
+				for b := range row {
+					hess[a][b] += w * row[a] * row[b]
+				}
+			}
+		}
+
+		for a := 1; a < k; a++ {
+			grad[a] -= lambda * coef[a]
+			hess[a][a] += lambda
+		}
+
+		delta, ok := solve(hess, grad)
+		if !ok {
+			break
+		}
+
+		next := make([]float64, k)
+		var change float64
+		for a := range coef {
+			next[a] = coef[a] + delta[a]
+			if math.IsNaN(next[a]) || math.IsInf(next[a], 0) {
+				return coef
+			}
+			change = math.Max(change, math.Abs(delta[a]))
+		}
+		coef = next
+
+		if change < 1e-9 {
+			break
+		}
+	}
+
+	return coef
+}
+
+// fitLinearRidge fits y = b0 + b·x to the given values using least
+// squares with a ridge penalty on every coefficient but the intercept.
+func fitLinearRidge(x [][]float64, y []float64, lambda float64) []float64 {
+	k := len(x[0]) + 1
+	xtx := make([][]float64, k)
+	for i := range xtx {
+		xtx[i] = make([]float64, k)
+	}
+	xty := make([]float64, k)
+
+	for i := range x {
+		row := append([]float64{1}, x[i]...)
+		for a := range row {
+			xty[a] += row[a] * y[i]
+			for b := range row {
+				xtx[a][b] += row[a] * row[b]
+			}
+		}
+	}
+
+	for a := 1; a < k; a++ {
+		xtx[a][a] += lambda
+	}
+
+	coef, ok := solve(xtx, xty)
+	if !ok {
+		coef = make([]float64, k)
+		coef[0], _ = meanStdDev(y)
+	}
+
+	return coef
+}
+
+// solve solves the linear system ax = b using Gaussian elimination with
+// partial pivoting. It returns false if the system is singular. The
+// arguments are modified.
+func solve(a [][]float64, b []float64) ([]float64, bool) {
+	n := len(b)
+	for col := 0; col < n; col++ {
+		pivot := col
+		for r := col + 1; r < n; r++ {
+			if math.Abs(a[r][col]) > math.Abs(a[pivot][col]) {
+				pivot = r
+			}
+		}
+		if math.Abs(a[pivot][col]) < 1e-12 {
+			return nil, false
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+		b[col], b[pivot] = b[pivot], b[col]
+
+		for r := col + 1; r < n; r++ {
+			f := a[r][col] / a[col][col]
+			for c := col; c < n; c++ {
+				a[r][c] -= f * a[col][c]
+			}
+			b[r] -= f * b[col]
+		}
+	}
+
+	x := make([]float64, n)
+	for r := n - 1; r >= 0; r-- {
+		sum := b[r]
+		for c := r + 1; c < n; c++ {
+			sum -= a[r][c] * x[c]
+		}
+		x[r] = sum / a[r][r]
+	}
+
+	return x, true
+}
+
+// RegularSeasonTeamStats retrieves every team's regular season stat
+// line for the given range of years, as used by the
+// TeamStatsPredictor.
+func (p *PLL) RegularSeasonTeamStats(ctx context.Context, fromYear, toYear int) ([]TeamSeason, error) {
+	var seasons []TeamSeason
+	for year := fromYear; year <= toYear; year++ {
+		res, err := p.TeamSeasonStats(ctx, year, "regular")
+		if err != nil {
+			return nil, err
+		}
+		seasons = append(seasons, res.AllTeams...)
+	}
+
+	return seasons, nil
+}
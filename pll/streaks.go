@@ -0,0 +1,208 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package pll
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// StreakKind
+type StreakKind string
+
+const (
+	WinStreak   StreakKind = "win"
+	LossStreak  StreakKind = "loss"
+	PointStreak StreakKind = "point"
+)
+
+// Streak is a run of consecutive games with the same result for a
+// team or player.
+type Streak struct {
+	Kind      StreakKind `json:"kind"`
+	TeamID    string     `json:"teamId"`
+	PlayerID  string     `json:"playerId"`
+	Length    int        `json:"length"`
+	FirstGame Game       `json:"firstGame"`
+	LastGame  Game       `json:"lastGame"`
+	Active    bool       `json:"active"` // the streak includes the most recent game
+}
+
+// TeamStreaks returns every win and loss streak of the given team in
+// the given games in chronological order. Ties end a streak. Games that
+// aren't final are ignored.
+func TeamStreaks(games []Game, teamID string) []Streak {
+	var played []Game
+	for _, g := range games {
+		if g.Final() && (g.HomeTeam.OfficialID == teamID || g.AwayTeam.OfficialID == teamID) {
+			played = append(played, g)
+		}
+	}
+	sortGames(played)
+
+	return streaks(played, func(g Game) StreakKind {
+		scores, against := g.HomeScore, g.AwayScore
+		if g.AwayTeam.OfficialID == teamID {
+			scores, against = against, scores
+		}
+
+		switch {
+		case scores > against:
+			return WinStreak
+		case scores < against:
+			return LossStreak
+		}
+		return ""
+	}, Streak{TeamID: teamID})
+}
+
+// PlayerPointStreaks returns every streak of consecutive games in which
+// the given player recorded a point, in chronological order.
+func PlayerPointStreaks(logs []PlayerGameLog, playerID string) []Streak {
+	sorted := slices.Clone(logs)
+	sortGameLogs(sorted)
+
+	games := make([]Game, 0, len(sorted))
+	points := make(map[string]bool, len(sorted))
+	var teamID string
+	for _, gl := range sorted {
+		games = append(games, gl.Game)
+		points[gl.Game.ID] = gl.Stats.Points > 0
+		teamID = gl.TeamID
+	}
+
+	return streaks(games, func(g Game) StreakKind {
+		if points[g.ID] {
+			return PointStreak
+		}
+		return ""
+	}, Streak{TeamID: teamID, PlayerID: playerID})
+}
+
+// streaks returns the runs of consecutive games with the same kind in
+// the given chronologically ordered games. Games with an empty kind
+// end a streak.
+func streaks(games []Game, kind func(Game) StreakKind, base Streak) []Streak {
+	var res []Streak
+	var cur *Streak
+
+	for i, g := range games {
+		k := kind(g)
+		if cur != nil && cur.Kind == k {
+			cur.Length++
+			cur.LastGame = g
+			cur.Active = i == len(games)-1
+			continue
+		}
+
+		if k == "" {
+			cur = nil
+			continue
+		}
+
+		s := base
+		s.Kind = k
+		s.Length = 1
+		s.FirstGame = g
+		s.LastGame = g
+		s.Active = i == len(games)-1
+		res = append(res, s)
+		cur = &res[len(res)-1]
+	}
+
+	return res
+}
+
+// sortGames orders the given games by start time.
+func sortGames(games []Game) {
+	slices.SortStableFunc(games, func(a, b Game) int {
+		return cmp.Compare(a.StartTime, b.StartTime)
+	})
+}
+
+// sortGameLogs orders the given game logs by the start time of their
+// games.
+func sortGameLogs(logs []PlayerGameLog) {
+	slices.SortStableFunc(logs, func(a, b PlayerGameLog) int {
+		return cmp.Compare(a.Game.StartTime, b.Game.StartTime)
+	})
+}
+
+// MilestoneRule describes a career milestone reached every multiple of
+// Every for the given stat, such as every 100 goals.
+type MilestoneRule struct {
+	Stat   string  `json:"stat"`
+	Every  float64 `json:"every"`
+	Within float64 `json:"within"` // how close a player must be to be reported
+}
+
+// Milestone is a career milestone a player is approaching.
+type Milestone struct {
+	Player    Player  `json:"player"`
+	Stat      string  `json:"stat"`
+	Current   float64 `json:"current"`
+	Target    float64 `json:"target"`
+	Remaining float64 `json:"remaining"`
+}
+
+// UpcomingMilestones returns every milestone the given players are
+// within reach of, ordered by the amount remaining.
+func UpcomingMilestones(careers []PlayerCareer, rules []MilestoneRule) []Milestone {
+	var res []Milestone
+	for _, c := range careers {
+		for _, r := range rules {
+			if r.Every <= 0 {
+				continue
+			}
+
+			cur, ok := statValue(c.Stats, r.Stat)
+			if !ok {
+				continue
+			}
+
+			target := (math.Floor(cur/r.Every) + 1) * r.Every
+			if target-cur > r.Within {
+				continue
+			}
+
+			res = append(res, Milestone{
+				Player:    c.Player,
+				Stat:      r.Stat,
+				Current:   cur,
+				Target:    target,
+				Remaining: target - cur,
+			})
+		}
+	}
+
+	slices.SortStableFunc(res, func(a, b Milestone) int {
+		return cmp.Compare(a.Remaining, b.Remaining)
+	})
+
+	return res
+}
@@ -0,0 +1,255 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package pll
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+)
+
+var TeamStatistics = []string{
+	"scores",
+	"scoresPG",
+	"goals",
+	"onePointGoals",
+	"twoPointGoals",
+	"assists",
+	"shots",
+	"shotsPG",
+	"shotPct",
+	"twoPointShotPct",
+	"shotsOnGoalPct",
+	"faceoffPct",
+	"faceoffsWon",
+	"groundBalls",
+	"turnovers",
+	"causedTurnovers",
+	"clearPct",
+	"ridesPct",
+	"saves",
+	"savePct",
+	"powerPlayPct",
+	"manDownPct",
+	"scoresAgainst",
+	"saa",
+	"numPenalties",
+	"pim",
+	"totalPasses",
+	"touches",
+}
+
+// Coach
+type Coach struct {
+	Name      string `json:"name"`
+	CoachType string `json:"coachType"`
+}
+
+// TeamStats contains a team's complete stat line for either a season
+// segment or a single game.
+type TeamStats struct {
+	Scores                     int     `json:"scores"`
+	FaceoffPct                 float64 `json:"faceoffPct"`
+	ShotPct                    float64 `json:"shotPct"`
+	TwoPointShotPct            float64 `json:"twoPointShotPct"`
+	TwoPointShotsOnGoalPct     float64 `json:"twoPointShotsOnGoalPct"`
+	ClearPct                   float64 `json:"clearPct"`
+	RidesPct                   float64 `json:"ridesPct"`
+	SavePct                    float64 `json:"savePct"`
+	ShortHandedPct             float64 `json:"shortHandedPct"`
+	ShortHandedGoalsAgainstPct float64 `json:"shortHandedGoalsAgainstPct"`
+	PowerPlayGoalsAgainstPct   float64 `json:"powerPlayGoalsAgainstPct"`
+	ManDownPct                 float64 `json:"manDownPct"`
+	ShotsOnGoalPct             float64 `json:"shotsOnGoalPct"`
+	OnePointGoals              int     `json:"onePointGoals"`
+	ScoresAgainst              int     `json:"scoresAgainst"`
+	Saa                        float64 `json:"saa"`
+	PowerPlayPct               float64 `json:"powerPlayPct"`
+	GamesPlayed                int     `json:"gamesPlayed"`
+	Goals                      int     `json:"goals"`
+	TwoPointGoals              int     `json:"twoPointGoals"`
+	Assists                    int     `json:"assists"`
+	GroundBalls                int     `json:"groundBalls"`
+	Turnovers                  int     `json:"turnovers"`
+	CausedTurnovers            int     `json:"causedTurnovers"`
+	FaceoffsWon                int     `json:"faceoffsWon"`
+	FaceoffsLost               int     `json:"faceoffsLost"`
+	Faceoffs                   int     `json:"faceoffs"`
+	Shots                      int     `json:"shots"`
+	TwoPointShots              int     `json:"twoPointShots"`
+	TwoPointShotsOnGoal        int     `json:"twoPointShotsOnGoal"`
+	GoalsAgainst               int     `json:"goalsAgainst"`
+	TwoPointGoalsAgainst       int     `json:"twoPointGoalsAgainst"`
+	NumPenalties               int     `json:"numPenalties"`
+	Pim                        float64 `json:"pim"`
+	Clears                     int     `json:"clears"`
+	ClearAttempts              int     `json:"clearAttempts"`
+	Rides                      int     `json:"rides"`
+	RideAttempts               int     `json:"rideAttempts"`
+	Saves                      int     `json:"saves"`
+	Offsides                   int     `json:"offsides"`
+	ShotClockExpirations       int     `json:"shotClockExpirations"`
+	PowerPlayGoals             int     `json:"powerPlayGoals"`
+	PowerPlayShots             int     `json:"powerPlayShots"`
+	ShortHandedGoals           int     `json:"shortHandedGoals"`
+	ShortHandedShots           int     `json:"shortHandedShots"`
+	ShortHandedShotsAgainst    int     `json:"shortHandedShotsAgainst"`
+	ShortHandedGoalsAgainst    int     `json:"shortHandedGoalsAgainst"`
+	PowerPlayGoalsAgainst      int     `json:"powerPlayGoalsAgainst"`
+	PowerPlayShotsAgainst      int     `json:"powerPlayShotsAgainst"`
+	TimesManUp                 int     `json:"timesManUp"`
+	TimesShortHanded           int     `json:"timesShortHanded"`
+	ShotsOnGoal                int     `json:"shotsOnGoal"`
+	ScoresPG                   float64 `json:"scoresPG"`
+	ShotsPG                    float64 `json:"shotsPG"`
+	TotalPasses                int     `json:"totalPasses"`
+	Touches                    int     `json:"touches"`
+}
+
+// TeamSeason is a team along with its record and stat line for the
+// requested year and season segment.
+type TeamSeason struct {
+	Team
+	Slogan        string    `json:"slogan"`
+	League        string    `json:"league"`
+	Coaches       []Coach   `json:"coaches"`
+	Year          int       `json:"year"`
+	SeasonSegment string    `json:"seasonSegment"`
+	Stats         TeamStats `json:"stats"`
+}
+
+// TeamSeasonStatsResponse
+type TeamSeasonStatsResponse struct {
+	AllTeams []TeamSeason `json:"allTeams"`
+}
+
+// TeamStatLeader
+type TeamStatLeader struct {
+	Team      Team    `json:"team"`
+	StatType  string  `json:"statType"`
+	StatValue float64 `json:"statValue"`
+	TeamRank  int     `json:"teamRank"`
+	Year      int     `json:"year"`
+}
+
+// TeamStatLeadersResponse
+type TeamStatLeadersResponse struct {
+	TeamStatLeaders []TeamStatLeader `json:"teamStatLeaders"`
+}
+
+// TeamSeasonStats retrieves the complete stat line of every team for
+// the given year and season segment.
+func (p *PLL) TeamSeasonStats(ctx context.Context, year int, seasonSegment string) (*TeamSeasonStatsResponse, error) {
+	if err := ValidSeasonSegment(seasonSegment); err != nil {
+		return nil, err
+	}
+
+	req := p.newRequest(teamSeasonStatsQuery)
+	req.Var("year", year)
+	req.Var("segment", seasonSegment)
+
+	var res TeamSeasonStatsResponse
+	if err := p.client.Run(ctx, req, &res); err != nil {
+		return nil, err
+	}
+
+	for i := range res.AllTeams {
+		res.AllTeams[i].Year = year
+		res.AllTeams[i].SeasonSegment = seasonSegment
+	}
+
+	return &res, nil
+}
+
+// TeamStatLeaders retrieves every team ranked by each of the given
+// stats for the given year and season segment. Leaders are grouped
+// by stat in the order requested and ties share a rank.
+func (p *PLL) TeamStatLeaders(ctx context.Context, year int, seasonSegment string, stats []string) (*TeamStatLeadersResponse, error) {
+	if err := ValidSeasonSegment(seasonSegment); err != nil {
+		return nil, err
+	}
+
+	if err := ValidTeamStats(stats); err != nil {
+		return nil, errors.New("invalid stats")
+	}
+
+	teams, err := p.TeamSeasonStats(ctx, year, seasonSegment)
+	if err != nil {
+		return nil, err
+	}
+
+	var res TeamStatLeadersResponse
+	for _, stat := range stats {
+		res.TeamStatLeaders = append(res.TeamStatLeaders, rankTeams(teams.AllTeams, stat)...)
+	}
+
+	return &res, nil
+}
+
+// rankTeams orders the given teams by the given stat.
+func rankTeams(teams []TeamSeason, stat string) []TeamStatLeader {
+	leaders := make([]TeamStatLeader, 0, len(teams))
+	for _, t := range teams {
+		v, _ := statValue(t.Stats, stat)
+		leaders = append(leaders, TeamStatLeader{
+			Team:      t.Team,
+			StatType:  stat,
+			StatValue: v,
+			Year:      t.Year,
+		})
+	}
+
+	asc := slices.Contains(lowerIsBetter, stat)
+	slices.SortStableFunc(leaders, func(a, b TeamStatLeader) int {
+		if asc {
+			return cmp.Compare(a.StatValue, b.StatValue)
+		}
+		return cmp.Compare(b.StatValue, a.StatValue)
+	})
+
+	for i := range leaders {
+		if i > 0 && leaders[i].StatValue == leaders[i-1].StatValue {
+			leaders[i].TeamRank = leaders[i-1].TeamRank
+			continue
+		}
+		leaders[i].TeamRank = i + 1
+	}
+
+	return leaders
+}
+
+// ValidTeamStats checks to see if the given team stats are valid.
+func ValidTeamStats(stats []string) error {
+	for _, stat := range stats {
+		if !slices.Contains(TeamStatistics, stat) {
+			return errors.New("invalid stat: " + stat)
+		}
+	}
+
+	return nil
+}
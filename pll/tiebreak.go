@@ -0,0 +1,401 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package pll
+
+import (
+	"cmp"
+	"context"
+	"slices"
+)
+
+// TeamRecord is a team's record computed from game results.
+type TeamRecord struct {
+	TeamID           string `json:"teamId"`
+	Wins             int    `json:"wins"`
+	Losses           int    `json:"losses"`
+	Ties             int    `json:"ties"`
+	ConferenceWins   int    `json:"conferenceWins"`
+	ConferenceLosses int    `json:"conferenceLosses"`
+	ConferenceTies   int    `json:"conferenceTies"`
+	Scores           int    `json:"scores"`
+	ScoresAgainst    int    `json:"scoresAgainst"`
+}
+
+// GamesPlayed returns the number of games in the record.
+func (r *TeamRecord) GamesPlayed() int {
+	return r.Wins + r.Losses + r.Ties
+}
+
+// WinPct returns the team's winning percentage with ties counted as
+// half a win.
+func (r *TeamRecord) WinPct() float64 {
+	return winPct(r.Wins, r.Losses, r.Ties)
+}
+
+// ConferenceWinPct returns the team's winning percentage in conference
+// games with ties counted as half a win.
+func (r *TeamRecord) ConferenceWinPct() float64 {
+	return winPct(r.ConferenceWins, r.ConferenceLosses, r.ConferenceTies)
+}
+
+// ScoreDiff returns the difference between the scores for and against
+// the team.
+func (r *TeamRecord) ScoreDiff() int {
+	return r.Scores - r.ScoresAgainst
+}
+
+// add records the result of a game from the perspective of the team
+// with the given scores.
+func (r *TeamRecord) add(scores, scoresAgainst int, conference bool) {
+	r.Scores += scores
+	r.ScoresAgainst += scoresAgainst
+
+	switch {
+	case scores > scoresAgainst:
+		r.Wins++
+		if conference {
+			r.ConferenceWins++
+		}
+	case scores < scoresAgainst:
+		r.Losses++
+		if conference {
+			r.ConferenceLosses++
+		}
+	default:
+		r.Ties++
+		if conference {
+			r.ConferenceTies++
+		}
+	}
+}
+
+// winPct returns the winning percentage for the given record with
+// ties counted as half a win.
+func winPct(wins, losses, ties int) float64 {
+	gp := wins + losses + ties
+	if gp == 0 {
+		return 0
+	}
+
+	return (float64(wins) + float64(ties)/2) / float64(gp)
+}
+
+// SeasonTable contains the records of every team computed from the
+// completed regular season games of a season.
+type SeasonTable struct {
+	Teams       map[string]Team
+	Records     map[string]*TeamRecord
+	Conferences map[string]Conference
+	Games       []Game
+
+	// seeds contains the seed reported by the API for each team and
+	// is used as the final tiebreaker.
+	seeds map[string]int
+}
+
+// NewSeasonTable creates a new value of SeasonTable from the given
+// games and standings. Only completed regular season games are
+// counted. Standings supply each team's conference and the seed used
+// when no tiebreaker applies.
+func NewSeasonTable(games []Game, standings []Standing) *SeasonTable {
+	t := SeasonTable{
+		Teams:       make(map[string]Team),
+		Records:     make(map[string]*TeamRecord),
+		Conferences: make(map[string]Conference),
+		seeds:       make(map[string]int),
+	}
+
+	for _, s := range standings {
+		t.addTeam(s.Team)
+		t.Conferences[s.Team.OfficialID] = s.Conference
+		t.seeds[s.Team.OfficialID] = s.Seed
+	}
+
+	for _, g := range games {
+		if g.SeasonSegment != "regular" || !g.Final() {
+			continue
+		}
+		t.AddGame(g)
+	}
+
+	return &t
+}
+
+// addTeam adds the given team to the table if not already present.
+func (t *SeasonTable) addTeam(team Team) {
+	if _, ok := t.Records[team.OfficialID]; ok {
+		return
+	}
+
+	t.Teams[team.OfficialID] = team
+	t.Records[team.OfficialID] = &TeamRecord{TeamID: team.OfficialID}
+}
+
+// AddGame adds the result of the given game to the table.
+func (t *SeasonTable) AddGame(g Game) {
+	t.addTeam(g.HomeTeam)
+	t.addTeam(g.AwayTeam)
+
+	home, away := g.HomeTeam.OfficialID, g.AwayTeam.OfficialID
+	conference := t.Conferences[home] != "" && t.Conferences[home] == t.Conferences[away]
+
+	t.Records[home].add(g.HomeScore, g.AwayScore, conference)
+	t.Records[away].add(g.AwayScore, g.HomeScore, conference)
+	t.Games = append(t.Games, g)
+}
+
+// headToHead returns the records of the given teams counting only the
+// games played between them.
+func (t *SeasonTable) headToHead(teams []string) map[string]*TeamRecord {
+	records := make(map[string]*TeamRecord, len(teams))
+	for _, team := range teams {
+		records[team] = &TeamRecord{TeamID: team}
+	}
+
+	for _, g := range t.Games {
+		home, hok := records[g.HomeTeam.OfficialID]
+		away, aok := records[g.AwayTeam.OfficialID]
+		if !hok || !aok {
+			continue
+		}
+		home.add(g.HomeScore, g.AwayScore, false)
+		away.add(g.AwayScore, g.HomeScore, false)
+	}
+
+	return records
+}
+
+// playedWithin reports whether every team in the given head-to-head
+// records has played at least one game within the group. Head-to-head
+// rules are skipped otherwise so a team that never met the others
+// isn't placed by them.
+func playedWithin(records map[string]*TeamRecord) bool {
+	for _, r := range records {
+		if r.GamesPlayed() == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Tiebreaker is a rule used to order teams with identical winning
+// percentages. Value returns the team's value for the rule given every
+// team still tied; higher values are seeded first.
+type Tiebreaker struct {
+	Name  string
+	Value func(t *SeasonTable, team string, tied []string) float64
+}
+
+// DefaultTiebreakers contains the PLL's published tiebreak rules in the
+// order they are applied.
+var DefaultTiebreakers = []Tiebreaker{
+	{
+		Name: "head-to-head record",
+		Value: func(t *SeasonTable, team string, tied []string) float64 {
+			records := t.headToHead(tied)
+			if !playedWithin(records) {
+				return 0
+			}
+			return records[team].WinPct()
+		},
+	},
+	{
+		Name: "head-to-head score differential",
+		Value: func(t *SeasonTable, team string, tied []string) float64 {
+			records := t.headToHead(tied)
+			if !playedWithin(records) {
+				return 0
+			}
+			return float64(records[team].ScoreDiff())
+		},
+	},
+	{
+		Name: "conference record",
+		Value: func(t *SeasonTable, team string, tied []string) float64 {
+			return t.Records[team].ConferenceWinPct()
+		},
+	},
+	{
+		Name: "score differential",
+		Value: func(t *SeasonTable, team string, tied []string) float64 {
+			return float64(t.Records[team].ScoreDiff())
+		},
+	},
+	{
+		Name: "scores",
+		Value: func(t *SeasonTable, team string, tied []string) float64 {
+			return float64(t.Records[team].Scores)
+		},
+	},
+	{
+		Name: "scores against",
+		Value: func(t *SeasonTable, team string, tied []string) float64 {
+			return -float64(t.Records[team].ScoresAgainst)
+		},
+	},
+}
+
+// officialSeed is the name reported when no tiebreaker separates teams
+// and the seed reported by the API is used instead.
+const officialSeed = "official seed"
+
+// Seeding is a team's seed as computed from a SeasonTable.
+type Seeding struct {
+	Seed     int        `json:"seed"`
+	Team     Team       `json:"team"`
+	Record   TeamRecord `json:"record"`
+	Tiebreak string     `json:"tiebreak"` // rule that placed the team within its tie, if any
+}
+
+// Seed orders every team in the table by winning percentage, breaking
+// ties with the given rules. When multiple teams are tied, the first
+// rule that separates them splits them into groups and each group is
+// broken again starting from the first rule. If rules is nil,
+// DefaultTiebreakers is used.
+func (t *SeasonTable) Seed(rules []Tiebreaker) []Seeding {
+	if rules == nil {
+		rules = DefaultTiebreakers
+	}
+
+	teams := make([]string, 0, len(t.Records))
+	for id := range t.Records {
+		teams = append(teams, id)
+	}
+	slices.Sort(teams)
+
+	groups := t.partition(teams, func(team string) float64 {
+		return t.Records[team].WinPct()
+	})
+
+	var seedings []Seeding
+	for _, group := range groups {
+		for _, p := range t.breakTie(group, rules) {
+			seedings = append(seedings, Seeding{
+				Seed:     len(seedings) + 1,
+				Team:     t.Teams[p.team],
+				Record:   *t.Records[p.team],
+				Tiebreak: p.rule,
+			})
+		}
+	}
+
+	return seedings
+}
+
+// placement is a team's position within a tie along with the rule that
+// determined it.
+type placement struct {
+	team string
+	rule string
+}
+
+// breakTie orders the given tied teams using the given rules.
+func (t *SeasonTable) breakTie(tied []string, rules []Tiebreaker) []placement {
+	if len(tied) == 1 {
+		return []placement{{team: tied[0]}}
+	}
+
+	for _, rule := range rules {
+		groups := t.partition(tied, func(team string) float64 {
+			return rule.Value(t, team, tied)
+		})
+		if len(groups) == 1 {
+			continue
+		}
+
+		var placements []placement
+		for _, group := range groups {
+			if len(group) == 1 {
+				placements = append(placements, placement{team: group[0], rule: rule.Name})
+				continue
+			}
+			placements = append(placements, t.breakTie(group, rules)...)
+		}
+
+		return placements
+	}
+
+	slices.SortStableFunc(tied, func(a, b string) int {
+		sa, sb := t.seeds[a], t.seeds[b]
+		if (sa == 0) != (sb == 0) {
+			if sa == 0 {
+				return 1
+			}
+			return -1
+		}
+		return cmp.Compare(sa, sb)
+	})
+
+	placements := make([]placement, 0, len(tied))
+	for _, team := range tied {
+		placements = append(placements, placement{team: team, rule: officialSeed})
+	}
+
+	return placements
+}
+
+// partition groups the given teams by value, ordered from highest to
+// lowest value.
+func (t *SeasonTable) partition(teams []string, value func(team string) float64) [][]string {
+	values := make(map[string]float64, len(teams))
+	for _, team := range teams {
+		values[team] = value(team)
+	}
+
+	sorted := slices.Clone(teams)
+	slices.SortStableFunc(sorted, func(a, b string) int {
+		return cmp.Compare(values[b], values[a])
+	})
+
+	var groups [][]string
+	for i, team := range sorted {
+		if i > 0 && values[team] == values[sorted[i-1]] {
+			groups[len(groups)-1] = append(groups[len(groups)-1], team)
+			continue
+		}
+		groups = append(groups, []string{team})
+	}
+
+	return groups
+}
+
+// Seedings retrieves the games and standings for the given year and
+// recomputes the regular season seeding using DefaultTiebreakers.
+func (p *PLL) Seedings(ctx context.Context, year int) ([]Seeding, error) {
+	games, err := p.Games(ctx, year)
+	if err != nil {
+		return nil, err
+	}
+
+	standings, err := p.Standings(ctx, year, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSeasonTable(games.SeasonEvents, standings.Standings).Seed(nil), nil
+}
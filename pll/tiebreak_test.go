@@ -0,0 +1,135 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package pll
+
+import (
+	"slices"
+	"testing"
+)
+
+// final returns a completed regular season game.
+func final(home, away string, homeScore, awayScore int) Game {
+	return Game{
+		SeasonSegment: "regular",
+		EventStatus:   gameStatusFinal,
+		HomeTeam:      Team{OfficialID: home},
+		AwayTeam:      Team{OfficialID: away},
+		HomeScore:     homeScore,
+		AwayScore:     awayScore,
+	}
+}
+
+func TestSeed(t *testing.T) {
+	tests := []struct {
+		name      string
+		games     []Game
+		seeds     map[string]int
+		wantTeams []string
+		wantRules []string
+	}{
+		{
+			name: "two team head-to-head",
+			games: []Game{
+				final("A", "B", 10, 9),
+				final("C", "A", 15, 5),
+				final("B", "D", 15, 2),
+				final("C", "D", 10, 9),
+			},
+			wantTeams: []string{"C", "A", "B", "D"},
+			wantRules: []string{"", "head-to-head record", "head-to-head record", ""},
+		},
+		{
+			name: "three-way tie with a team that never met the group",
+			games: []Game{
+				final("A", "B", 10, 5),
+				final("B", "D", 12, 10),
+				final("D", "A", 9, 8),
+				final("C", "D", 10, 9),
+				final("D", "C", 11, 10),
+				final("E", "D", 5, 1),
+				final("E", "D", 5, 1),
+			},
+			wantTeams: []string{"E", "A", "C", "B", "D"},
+			wantRules: []string{"", "score differential", "score differential", "score differential", ""},
+		},
+		{
+			name: "split restarts from the first rule",
+			games: []Game{
+				final("A", "B", 10, 9),
+				final("X", "A", 10, 9),
+				final("B", "Y", 11, 10),
+				final("X", "Y", 10, 0),
+				final("C", "Z", 20, 10),
+				final("W", "C", 15, 10),
+				final("W", "Z", 10, 5),
+			},
+			wantTeams: []string{"X", "W", "C", "A", "B", "Y", "Z"},
+			wantRules: []string{
+				"score differential", "score differential", "score differential",
+				"head-to-head record", "head-to-head record",
+				"score differential", "score differential",
+			},
+		},
+		{
+			name: "fallback to official seed",
+			games: []Game{
+				final("A", "X", 10, 5),
+				final("Y", "A", 10, 5),
+				final("B", "Y", 10, 5),
+				final("X", "B", 10, 5),
+			},
+			seeds:     map[string]int{"Y": 1, "B": 2, "A": 3},
+			wantTeams: []string{"Y", "B", "A", "X"},
+			wantRules: []string{officialSeed, officialSeed, officialSeed, officialSeed},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var standings []Standing
+			for id, seed := range tt.seeds {
+				standings = append(standings, Standing{Team: Team{OfficialID: id}, Seed: seed})
+			}
+
+			var teams, rules []string
+			for i, s := range NewSeasonTable(tt.games, standings).Seed(nil) {
+				if s.Seed != i+1 {
+					t.Errorf("seed %d reported as %d", i+1, s.Seed)
+				}
+				teams = append(teams, s.Team.OfficialID)
+				rules = append(rules, s.Tiebreak)
+			}
+
+			if !slices.Equal(teams, tt.wantTeams) {
+				t.Errorf("teams = %v, want %v", teams, tt.wantTeams)
+			}
+			if !slices.Equal(rules, tt.wantRules) {
+				t.Errorf("rules = %q, want %q", rules, tt.wantRules)
+			}
+		})
+	}
+}
@@ -0,0 +1,290 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package pll
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// significanceZ is the z-score beyond which a change is considered
+// statistically significant, a two tailed test at the 5% level.
+const significanceZ = 1.96
+
+// proportionStats maps percentage stats to the counts they're computed
+// from, the numerator and the fields summed for the denominator.
+var proportionStats = map[string][2][]string{
+	"shotPct":         {{"goals"}, {"shots"}},
+	"twoPointShotPct": {{"twoPointGoals"}, {"twoPointShots"}},
+	"shotsOnGoalPct":  {{"shotsOnGoal"}, {"shots"}},
+	"faceoffPct":      {{"faceoffsWon"}, {"faceoffs"}},
+	"savePct":         {{"saves"}, {"saves", "goalsAgainst"}},
+	"clearPct":        {{"clears"}, {"clearAttempts"}},
+	"ridesPct":        {{"rides"}, {"rideAttempts"}},
+	"powerPlayPct":    {{"powerPlayGoals"}, {"timesManUp"}},
+}
+
+// TrendPoint is the value of a stat for a single season along with its
+// change from the previous season.
+type TrendPoint struct {
+	Year        int     `json:"year"`
+	Value       float64 `json:"value"`
+	Delta       float64 `json:"delta"`
+	PctChange   float64 `json:"pctChange"`
+	ZScore      float64 `json:"zScore"`
+	Significant bool    `json:"significant"`
+}
+
+// Trend is the series of a single stat across seasons for a player or
+// team.
+type Trend struct {
+	ID     string       `json:"id"`
+	Stat   string       `json:"stat"`
+	Points []TrendPoint `json:"points"`
+}
+
+// PlayerTrend returns the given stat of the given player for each of
+// the given seasons in which they played, ordered by year.
+func PlayerTrend(seasons []PlayerSeason, officialID, stat string) (*Trend, error) {
+	if _, ok := statValue(PlayerStats{}, stat); !ok {
+		return nil, errors.New("invalid stat: " + stat)
+	}
+
+	var stats []seasonStats
+	for _, ps := range seasons {
+		if ps.OfficialID == officialID {
+			stats = append(stats, seasonStats{ps.Year, ps.Stats.GamesPlayed, ps.Stats})
+		}
+	}
+
+	return trend(officialID, stat, stats), nil
+}
+
+// TeamTrend returns the given stat of the given team for each of the
+// given seasons, ordered by year.
+func TeamTrend(seasons []TeamSeason, teamID, stat string) (*Trend, error) {
+	if _, ok := statValue(TeamStats{}, stat); !ok {
+		return nil, errors.New("invalid stat: " + stat)
+	}
+
+	var stats []seasonStats
+	for _, ts := range seasons {
+		if ts.OfficialID == teamID {
+			stats = append(stats, seasonStats{ts.Year, ts.Stats.GamesPlayed, ts.Stats})
+		}
+	}
+
+	return trend(teamID, stat, stats), nil
+}
+
+// seasonStats is a stat line of either a player or team for a season.
+type seasonStats struct {
+	year        int
+	gamesPlayed int
+	stats       any
+}
+
+// trend builds the trend of the given stat across the given seasons.
+// Changes in percentage stats are tested with a two proportion z-test
+// on the underlying counts. Changes in counting and per game stats are
+// tested as differences in Poisson rates per game. Other stats are
+// never flagged as significant.
+func trend(id, stat string, seasons []seasonStats) *Trend {
+	slices.SortFunc(seasons, func(a, b seasonStats) int {
+		return cmp.Compare(a.year, b.year)
+	})
+
+	t := Trend{
+		ID:   id,
+		Stat: stat,
+	}
+
+	for i, s := range seasons {
+		v, _ := statValue(s.stats, stat)
+		tp := TrendPoint{
+			Year:  s.year,
+			Value: v,
+		}
+
+		if i > 0 {
+			prev := seasons[i-1]
+			pv, _ := statValue(prev.stats, stat)
+			tp.Delta = v - pv
+			if pv != 0 {
+				tp.PctChange = tp.Delta / pv
+			}
+			tp.ZScore = changeZScore(stat, prev, s)
+			tp.Significant = math.Abs(tp.ZScore) >= significanceZ
+		}
+
+		t.Points = append(t.Points, tp)
+	}
+
+	return &t
+}
+
+// changeZScore returns the z-score of the change in the given stat
+// between two seasons or 0 if the change can't be tested.
+func changeZScore(stat string, a, b seasonStats) float64 {
+	if counts, ok := proportionStats[stat]; ok {
+		x1, n1 := sumStats(a.stats, counts[0]), sumStats(a.stats, counts[1])
+		x2, n2 := sumStats(b.stats, counts[0]), sumStats(b.stats, counts[1])
+		if n1 == 0 || n2 == 0 {
+			return 0
+		}
+
+		p := (x1 + x2) / (n1 + n2)
+		se := math.Sqrt(p * (1 - p) * (1/n1 + 1/n2))
+		if se == 0 {
+			return 0
+		}
+
+		return (x2/n2 - x1/n1) / se
+	}
+
+	if a.gamesPlayed == 0 || b.gamesPlayed == 0 {
+		return 0
+	}
+
+	r1, ok1 := perGameRate(a, stat)
+	r2, ok2 := perGameRate(b, stat)
+	if !ok1 || !ok2 {
+		return 0
+	}
+
+	se := math.Sqrt(r1/float64(a.gamesPlayed) + r2/float64(b.gamesPlayed))
+	if se == 0 {
+		return 0
+	}
+
+	return (r2 - r1) / se
+}
+
+// perGameRate returns the per game rate of the given counting or per
+// game stat.
+func perGameRate(s seasonStats, stat string) (float64, bool) {
+	v, _ := statValue(s.stats, stat)
+
+	rt := reflect.Indirect(reflect.ValueOf(s.stats)).Type()
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		if jsonName(f) != stat {
+			continue
+		}
+
+		switch {
+		case f.Type.Kind() == reflect.Int:
+			return v / float64(s.gamesPlayed), true
+		case strings.HasSuffix(stat, "PG"):
+			return v, true
+		}
+	}
+
+	return 0, false
+}
+
+// sumStats returns the sum of the given stats.
+func sumStats(v any, stats []string) float64 {
+	var sum float64
+	for _, stat := range stats {
+		n, _ := statValue(v, stat)
+		sum += n
+	}
+
+	return sum
+}
+
+// GameLogSeries returns the given stat from each of the given game
+// logs in chronological order.
+func GameLogSeries(logs []PlayerGameLog, stat string) []float64 {
+	sorted := slices.Clone(logs)
+	sortGameLogs(sorted)
+
+	series := make([]float64, 0, len(sorted))
+	for _, gl := range sorted {
+		v, _ := statValue(gl.Stats, stat)
+		series = append(series, v)
+	}
+
+	return series
+}
+
+// RollingAverage returns the average of each value and the values
+// before it within the given window. The first values average over
+// however many values are available.
+func RollingAverage(values []float64, window int) []float64 {
+	if window <= 0 {
+		window = 1
+	}
+
+	avgs := make([]float64, 0, len(values))
+	var sum float64
+	for i, v := range values {
+		sum += v
+		if i >= window {
+			sum -= values[i-window]
+		}
+		avgs = append(avgs, sum/float64(min(i+1, window)))
+	}
+
+	return avgs
+}
+
+// PlayerTrend retrieves the given player's stat line for each year in
+// the given range and returns the trend of the given stat.
+func (p *PLL) PlayerTrend(ctx context.Context, officialID, stat string, fromYear, toYear int, seasonSegment string) (*Trend, error) {
+	var seasons []PlayerSeason
+	for year := fromYear; year <= toYear; year++ {
+		res, err := p.PlayerSeasonStats(ctx, year, seasonSegment)
+		if err != nil {
+			return nil, err
+		}
+		seasons = append(seasons, res.AllPlayers...)
+	}
+
+	return PlayerTrend(seasons, officialID, stat)
+}
+
+// TeamTrend retrieves the given team's stat line for each year in the
+// given range and returns the trend of the given stat.
+func (p *PLL) TeamTrend(ctx context.Context, teamID, stat string, fromYear, toYear int, seasonSegment string) (*Trend, error) {
+	var seasons []TeamSeason
+	for year := fromYear; year <= toYear; year++ {
+		res, err := p.TeamSeasonStats(ctx, year, seasonSegment)
+		if err != nil {
+			return nil, err
+		}
+		seasons = append(seasons, res.AllTeams...)
+	}
+
+	return TeamTrend(seasons, teamID, stat)
+}
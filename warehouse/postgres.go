@@ -0,0 +1,329 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package warehouse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/briandowns/pll/pll"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// postgresTypes maps each column kind to its PostgreSQL type.
+var postgresTypes = map[int]string{
+	kindText:      "TEXT",
+	kindInteger:   "BIGINT",
+	kindReal:      "DOUBLE PRECISION",
+	kindBoolean:   "BOOLEAN",
+	kindTimestamp: "TIMESTAMPTZ",
+}
+
+// Postgres stores PLL data in a PostgreSQL database. Rows are written
+// in bulk with COPY into a temporary table and upserted from there.
+type Postgres struct {
+	pool *pgxpool.Pool
+}
+
+var _ Store = (*Postgres)(nil)
+
+// OpenPostgres connects to the PostgreSQL database described by the
+// given connection string and applies any pending migrations.
+func OpenPostgres(ctx context.Context, connString string) (*Postgres, error) {
+	pool, err := pgxpool.New(ctx, connString)
+	if err != nil {
+		return nil, err
+	}
+
+	s := Postgres{pool: pool}
+	if err := s.migrate(ctx); err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// Close closes every connection to the database.
+func (s *Postgres) Close() error {
+	s.pool.Close()
+	return nil
+}
+
+// migrate applies every migration newer than the schema version
+// recorded in the database.
+func (s *Postgres) migrate(ctx context.Context) error {
+	const schemaMigrations = `CREATE TABLE IF NOT EXISTS schema_migrations (
+	version BIGINT NOT NULL PRIMARY KEY,
+	applied_at TIMESTAMPTZ NOT NULL
+)`
+	if _, err := s.pool.Exec(ctx, schemaMigrations); err != nil {
+		return err
+	}
+
+	var current int
+	row := s.pool.QueryRow(ctx, "SELECT COALESCE(MAX(version), 0) FROM schema_migrations")
+	if err := row.Scan(&current); err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		if m.version <= current {
+			continue
+		}
+
+		err := s.withTx(ctx, func(w *postgresWriter) error {
+			for _, t := range m.tables {
+				if _, err := w.tx.Exec(ctx, createTable(t, postgresTypes)); err != nil {
+					return err
+				}
+			}
+
+			_, err := w.tx.Exec(ctx, "INSERT INTO schema_migrations (version, applied_at) VALUES ($1, $2)", m.version, time.Now().UTC())
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// postgresWriter writes rows within a PostgreSQL transaction.
+type postgresWriter struct {
+	tx pgx.Tx
+}
+
+// withTx runs the given function in a transaction, committing if it
+// succeeds and rolling back otherwise.
+func (s *Postgres) withTx(ctx context.Context, fn func(w *postgresWriter) error) error {
+	return pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		return fn(&postgresWriter{tx: tx})
+	})
+}
+
+// upsert copies the rows into a temporary table and merges them from
+// there. When multiple rows share a primary key, the last one is kept.
+func (w *postgresWriter) upsert(ctx context.Context, table string, rows [][]any) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	def := lookupTable(table)
+	cols := tableColumns(table)
+	rows = dedupe(def, rows)
+
+	tmp := "tmp_" + table
+	if _, err := w.tx.Exec(ctx, fmt.Sprintf("CREATE TEMP TABLE IF NOT EXISTS %s (LIKE %s) ON COMMIT DROP", tmp, table)); err != nil {
+		return err
+	}
+	if _, err := w.tx.Exec(ctx, "TRUNCATE "+tmp); err != nil {
+		return err
+	}
+
+	if _, err := w.tx.CopyFrom(ctx, pgx.Identifier{tmp}, cols, pgx.CopyFromRows(rows)); err != nil {
+		return err
+	}
+
+	var updates []string
+	for _, c := range cols {
+		if !slices.Contains(def.primaryKey, c) {
+			updates = append(updates, c+" = EXCLUDED."+c)
+		}
+	}
+
+	conflict := "DO NOTHING"
+	if len(updates) > 0 {
+		conflict = "DO UPDATE SET " + strings.Join(updates, ", ")
+	}
+
+	list := strings.Join(cols, ", ")
+	query := fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s ON CONFLICT (%s) %s",
+		table, list, list, tmp, strings.Join(def.primaryKey, ", "), conflict)
+
+	_, err := w.tx.Exec(ctx, query)
+	return err
+}
+
+func (w *postgresWriter) deleteWhere(ctx context.Context, table string, cols []string, vals ...any) error {
+	conds := make([]string, 0, len(cols))
+	for i, c := range cols {
+		conds = append(conds, fmt.Sprintf("%s = $%d", c, i+1))
+	}
+
+	_, err := w.tx.Exec(ctx, "DELETE FROM "+table+" WHERE "+strings.Join(conds, " AND "), vals...)
+	return err
+}
+
+// dedupe removes rows sharing a primary key with a later row.
+func dedupe(t table, rows [][]any) [][]any {
+	var idx []int
+	for i, c := range t.columns {
+		if slices.Contains(t.primaryKey, c.name) {
+			idx = append(idx, i)
+		}
+	}
+
+	last := make(map[string]int, len(rows))
+	for i, row := range rows {
+		last[rowKey(row, idx)] = i
+	}
+
+	deduped := make([][]any, 0, len(last))
+	for i, row := range rows {
+		if last[rowKey(row, idx)] == i {
+			deduped = append(deduped, row)
+		}
+	}
+
+	return deduped
+}
+
+// rowKey returns the values of the given columns of the row as a
+// string.
+func rowKey(row []any, idx []int) string {
+	var b strings.Builder
+	for _, i := range idx {
+		fmt.Fprintf(&b, "%v\x00", row[i])
+	}
+
+	return b.String()
+}
+
+// SaveTeams
+func (s *Postgres) SaveTeams(ctx context.Context, teams []pll.Team) error {
+	return s.withTx(ctx, func(w *postgresWriter) error {
+		return saveTeams(ctx, w, teams)
+	})
+}
+
+// SaveStandings saves the given regular season standings and their
+// teams for the given year.
+func (s *Postgres) SaveStandings(ctx context.Context, year int, standings []pll.Standing) error {
+	return s.withTx(ctx, func(w *postgresWriter) error {
+		return saveStandings(ctx, w, year, standings)
+	})
+}
+
+// SaveTeamSeasons saves the given teams along with their stat lines
+// and coaches.
+func (s *Postgres) SaveTeamSeasons(ctx context.Context, seasons []pll.TeamSeason) error {
+	return s.withTx(ctx, func(w *postgresWriter) error {
+		return saveTeamSeasons(ctx, w, seasons)
+	})
+}
+
+// SavePlayerSeasons saves the given players along with their stat
+// lines.
+func (s *Postgres) SavePlayerSeasons(ctx context.Context, seasons []pll.PlayerSeason) error {
+	return s.withTx(ctx, func(w *postgresWriter) error {
+		return savePlayerSeasons(ctx, w, seasons)
+	})
+}
+
+// SavePlayerStatLeaders replaces the stat leaders for the given year
+// and season segment.
+func (s *Postgres) SavePlayerStatLeaders(ctx context.Context, year int, segment string, leaders []pll.PlayerStatLeader) error {
+	return s.withTx(ctx, func(w *postgresWriter) error {
+		return savePlayerStatLeaders(ctx, w, year, segment, leaders)
+	})
+}
+
+// SaveGames saves the given games and their teams.
+func (s *Postgres) SaveGames(ctx context.Context, games []pll.Game) error {
+	return s.withTx(ctx, func(w *postgresWriter) error {
+		return saveGames(ctx, w, games)
+	})
+}
+
+// SaveBoxScore replaces the team and player stat lines of the given
+// game.
+func (s *Postgres) SaveBoxScore(ctx context.Context, bs pll.BoxScore) error {
+	return s.withTx(ctx, func(w *postgresWriter) error {
+		return saveBoxScore(ctx, w, bs)
+	})
+}
+
+// Games returns the stored games of the given year. Only the ID of
+// each team is populated.
+func (s *Postgres) Games(ctx context.Context, year int) ([]pll.Game, error) {
+	const query = `SELECT id, slug, year, week, start_time, segment, event_status, home_team_id, away_team_id, home_score, away_score
+FROM games WHERE year = $1`
+
+	rows, err := s.pool.Query(ctx, query, year)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var games []pll.Game
+	for rows.Next() {
+		var g pll.Game
+		var start time.Time
+		err := rows.Scan(&g.ID, &g.Slug, &g.Year, &g.Week, &start, &g.SeasonSegment, &g.EventStatus,
+			&g.HomeTeam.OfficialID, &g.AwayTeam.OfficialID, &g.HomeScore, &g.AwayScore)
+		if err != nil {
+			return nil, err
+		}
+		g.StartTime = pll.UnixTime(start.Unix())
+		games = append(games, g)
+	}
+
+	return games, rows.Err()
+}
+
+// RecordSync saves the given sync metadata.
+func (s *Postgres) RecordSync(ctx context.Context, rec SyncRecord) error {
+	return s.withTx(ctx, func(w *postgresWriter) error {
+		return w.upsert(ctx, "sync_metadata", [][]any{syncRecordRow(rec)})
+	})
+}
+
+// LastSync returns the metadata of the last sync of the given table,
+// year and season segment or nil if it has never been synced.
+func (s *Postgres) LastSync(ctx context.Context, table string, year int, segment string) (*SyncRecord, error) {
+	const query = `SELECT table_name, year, segment, synced_at, row_count, complete
+FROM sync_metadata WHERE table_name = $1 AND year = $2 AND segment = $3`
+
+	var rec SyncRecord
+	row := s.pool.QueryRow(ctx, query, table, year, segment)
+	err := row.Scan(&rec.Table, &rec.Year, &rec.Segment, &rec.SyncedAt, &rec.Rows, &rec.Complete)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &rec, nil
+}
@@ -0,0 +1,117 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package warehouse
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/briandowns/pll/pll"
+)
+
+// statValues returns the value of each field of the given stat line in
+// the order of its columns.
+func statValues(v any) []any {
+	rv := reflect.ValueOf(v)
+	vals := make([]any, 0, rv.NumField())
+	for i := 0; i < rv.NumField(); i++ {
+		vals = append(vals, rv.Field(i).Interface())
+	}
+
+	return vals
+}
+
+// text returns the given untyped API value as a string.
+func text(v any) string {
+	if v == nil {
+		return ""
+	}
+
+	return fmt.Sprint(v)
+}
+
+// teamRow
+func teamRow(t pll.Team) []any {
+	return []any{t.OfficialID, t.FullName, text(t.Location), text(t.LocationCode), t.URLLogo}
+}
+
+// standingRow
+func standingRow(year int, s pll.Standing) []any {
+	return []any{
+		year, s.Team.OfficialID, s.Seed, s.Wins, s.Losses, s.Ties,
+		s.Scores, s.ScoresAgainst, s.ScoreDiff, string(s.Conference),
+		s.ConferenceSeed, s.ConferenceWins, s.ConferenceLosses,
+		s.ConferenceTies, s.ConferenceScores, s.ConferenceScoresAgainst,
+	}
+}
+
+// teamSeasonRow
+func teamSeasonRow(ts pll.TeamSeason) []any {
+	return append([]any{ts.OfficialID, ts.Year, ts.SeasonSegment, ts.Slogan, ts.League}, statValues(ts.Stats)...)
+}
+
+// coachRow
+func coachRow(teamID string, year int, c pll.Coach) []any {
+	return []any{teamID, year, c.Name, c.CoachType}
+}
+
+// playerRow
+func playerRow(p pll.Player) []any {
+	return []any{
+		p.OfficialID, p.ProfileURL, p.FirstName, p.LastName, p.Slug,
+		p.JerseyNum, p.Handedness, p.Position, p.PositionName,
+	}
+}
+
+// playerSeasonRow
+func playerSeasonRow(ps pll.PlayerSeason) []any {
+	return append([]any{ps.OfficialID, ps.Year, ps.SeasonSegment, ps.Team.OfficialID}, statValues(ps.Stats)...)
+}
+
+// leaderRow
+func leaderRow(year int, segment string, l pll.PlayerStatLeader) []any {
+	return []any{year, segment, l.StatType, l.OfficialID, l.TeamID, l.StatValue, l.PlayerRank}
+}
+
+// gameRow
+func gameRow(g pll.Game) []any {
+	return []any{
+		g.ID, g.Slug, g.Year, g.Week, g.Start().UTC(), g.SeasonSegment,
+		g.EventStatus, g.HomeTeam.OfficialID, g.AwayTeam.OfficialID,
+		g.HomeScore, g.AwayScore,
+	}
+}
+
+// boxScoreTeamRow
+func boxScoreTeamRow(gameID string, t pll.BoxScoreTeam) []any {
+	return append([]any{gameID, t.OfficialID}, statValues(t.Stats)...)
+}
+
+// boxScorePlayerRow
+func boxScorePlayerRow(gameID string, p pll.BoxScorePlayer) []any {
+	return append([]any{gameID, p.OfficialID, p.TeamID}, statValues(p.Stats)...)
+}
@@ -0,0 +1,304 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package warehouse
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/briandowns/pll/pll"
+)
+
+// column kinds shared by every dialect
+const (
+	kindText = iota
+	kindInteger
+	kindReal
+	kindBoolean
+	kindTimestamp
+)
+
+// column
+type column struct {
+	name string
+	kind int
+}
+
+// table
+type table struct {
+	name       string
+	columns    []column
+	primaryKey []string
+}
+
+// migration is a numbered set of tables created together.
+type migration struct {
+	version int
+	tables  []table
+}
+
+// playerStatsColumns contains a column for each field of
+// pll.PlayerStats.
+var playerStatsColumns = statColumns(reflect.TypeOf(pll.PlayerStats{}))
+
+// teamStatsColumns contains a column for each field of pll.TeamStats.
+var teamStatsColumns = statColumns(reflect.TypeOf(pll.TeamStats{}))
+
+// statColumns returns a column for each field of the given stat line
+// struct in field order, the order statValues returns values in, named
+// by converting the field's JSON name to snake case. Adding a field to
+// the struct requires a migration adding its column.
+func statColumns(t reflect.Type) []column {
+	cols := make([]column, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+
+		kind := kindText
+		switch f.Type.Kind() {
+		case reflect.Int, reflect.Int64:
+			kind = kindInteger
+		case reflect.Float64:
+			kind = kindReal
+		case reflect.Bool:
+			kind = kindBoolean
+		}
+
+		cols = append(cols, column{snakeCase(name), kind})
+	}
+
+	return cols
+}
+
+// snakeCase converts the given camel case name to snake case. Runs of
+// capitals are kept together, e.g. pointsPG becomes points_pg.
+func snakeCase(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 && !unicode.IsUpper(runes[i-1]) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
+
+// withColumns returns the given key columns followed by the given
+// columns.
+func withColumns(keys []column, cols []column) []column {
+	return append(append([]column{}, keys...), cols...)
+}
+
+// migrations contains every migration in the order they're applied.
+var migrations = []migration{
+	{
+		version: 1,
+		tables: []table{
+			{
+				name: "sync_metadata",
+				columns: []column{
+					{"table_name", kindText},
+					{"year", kindInteger},
+					{"segment", kindText},
+					{"synced_at", kindTimestamp},
+					{"row_count", kindInteger},
+					{"complete", kindBoolean},
+				},
+				primaryKey: []string{"table_name", "year", "segment"},
+			},
+			{
+				name: "teams",
+				columns: []column{
+					{"official_id", kindText},
+					{"full_name", kindText},
+					{"location", kindText},
+					{"location_code", kindText},
+					{"url_logo", kindText},
+				},
+				primaryKey: []string{"official_id"},
+			},
+			{
+				name: "standings",
+				columns: []column{
+					{"year", kindInteger},
+					{"team_id", kindText},
+					{"seed", kindInteger},
+					{"wins", kindInteger},
+					{"losses", kindInteger},
+					{"ties", kindInteger},
+					{"scores", kindInteger},
+					{"scores_against", kindInteger},
+					{"score_diff", kindInteger},
+					{"conference", kindText},
+					{"conference_seed", kindInteger},
+					{"conference_wins", kindInteger},
+					{"conference_losses", kindInteger},
+					{"conference_ties", kindInteger},
+					{"conference_scores", kindInteger},
+					{"conference_scores_against", kindInteger},
+				},
+				primaryKey: []string{"year", "team_id"},
+			},
+			{
+				name: "team_seasons",
+				columns: withColumns([]column{
+					{"team_id", kindText},
+					{"year", kindInteger},
+					{"segment", kindText},
+					{"slogan", kindText},
+					{"league", kindText},
+				}, teamStatsColumns),
+				primaryKey: []string{"team_id", "year", "segment"},
+			},
+			{
+				name: "team_coaches",
+				columns: []column{
+					{"team_id", kindText},
+					{"year", kindInteger},
+					{"name", kindText},
+					{"coach_type", kindText},
+				},
+				primaryKey: []string{"team_id", "year", "name"},
+			},
+			{
+				name: "players",
+				columns: []column{
+					{"official_id", kindText},
+					{"profile_url", kindText},
+					{"first_name", kindText},
+					{"last_name", kindText},
+					{"slug", kindText},
+					{"jersey_num", kindText},
+					{"handedness", kindText},
+					{"position", kindText},
+					{"position_name", kindText},
+				},
+				primaryKey: []string{"official_id"},
+			},
+			{
+				name: "player_seasons",
+				columns: withColumns([]column{
+					{"player_id", kindText},
+					{"year", kindInteger},
+					{"segment", kindText},
+					{"team_id", kindText},
+				}, playerStatsColumns),
+				primaryKey: []string{"player_id", "year", "segment"},
+			},
+			{
+				name: "player_stat_leaders",
+				columns: []column{
+					{"year", kindInteger},
+					{"segment", kindText},
+					{"stat_type", kindText},
+					{"player_id", kindText},
+					{"team_id", kindText},
+					{"stat_value", kindText},
+					{"player_rank", kindInteger},
+				},
+				primaryKey: []string{"year", "segment", "stat_type", "player_id"},
+			},
+			{
+				name: "games",
+				columns: []column{
+					{"id", kindText},
+					{"slug", kindText},
+					{"year", kindInteger},
+					{"week", kindInteger},
+					{"start_time", kindTimestamp},
+					{"segment", kindText},
+					{"event_status", kindInteger},
+					{"home_team_id", kindText},
+					{"away_team_id", kindText},
+					{"home_score", kindInteger},
+					{"away_score", kindInteger},
+				},
+				primaryKey: []string{"id"},
+			},
+			{
+				name: "box_score_teams",
+				columns: withColumns([]column{
+					{"game_id", kindText},
+					{"team_id", kindText},
+				}, teamStatsColumns),
+				primaryKey: []string{"game_id", "team_id"},
+			},
+			{
+				name: "box_score_players",
+				columns: withColumns([]column{
+					{"game_id", kindText},
+					{"player_id", kindText},
+					{"team_id", kindText},
+				}, playerStatsColumns),
+				primaryKey: []string{"game_id", "player_id"},
+			},
+		},
+	},
+}
+
+// lookupTable returns the definition of the given table as of the
+// latest migration.
+func lookupTable(name string) table {
+	var def table
+	for _, m := range migrations {
+		for _, t := range m.tables {
+			if t.name == name {
+				def = t
+			}
+		}
+	}
+
+	return def
+}
+
+// tableColumns returns the names of the columns of the given table as
+// of the latest migration.
+func tableColumns(name string) []string {
+	var cols []string
+	for _, c := range lookupTable(name).columns {
+		cols = append(cols, c.name)
+	}
+
+	return cols
+}
+
+// createTable renders the DDL creating the given table using the given
+// names for each column kind.
+func createTable(t table, types map[int]string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "CREATE TABLE IF NOT EXISTS %s (\n", t.name)
+	for _, c := range t.columns {
+		fmt.Fprintf(&b, "\t%s %s NOT NULL,\n", c.name, types[c.kind])
+	}
+	fmt.Fprintf(&b, "\tPRIMARY KEY (%s)\n)", strings.Join(t.primaryKey, ", "))
+
+	return b.String()
+}
@@ -0,0 +1,280 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package warehouse
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/briandowns/pll/pll"
+
+	_ "modernc.org/sqlite"
+)
+
+// sqliteTypes maps each column kind to its SQLite type.
+var sqliteTypes = map[int]string{
+	kindText:      "TEXT",
+	kindInteger:   "INTEGER",
+	kindReal:      "REAL",
+	kindBoolean:   "BOOLEAN",
+	kindTimestamp: "TIMESTAMP",
+}
+
+// SQLite stores PLL data in a SQLite database.
+type SQLite struct {
+	db *sql.DB
+}
+
+var _ Store = (*SQLite)(nil)
+
+// OpenSQLite opens the SQLite database at the given path, creating it
+// if it doesn't exist, and applies any pending migrations.
+func OpenSQLite(ctx context.Context, path string) (*SQLite, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, err
+	}
+
+	s := SQLite{db: db}
+	if err := s.migrate(ctx); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// Close closes the database.
+func (s *SQLite) Close() error {
+	return s.db.Close()
+}
+
+// migrate applies every migration newer than the schema version
+// recorded in the database.
+func (s *SQLite) migrate(ctx context.Context) error {
+	const schemaMigrations = `CREATE TABLE IF NOT EXISTS schema_migrations (
+	version INTEGER NOT NULL PRIMARY KEY,
+	applied_at TIMESTAMP NOT NULL
+)`
+	if _, err := s.db.ExecContext(ctx, schemaMigrations); err != nil {
+		return err
+	}
+
+	var current int
+	row := s.db.QueryRowContext(ctx, "SELECT COALESCE(MAX(version), 0) FROM schema_migrations")
+	if err := row.Scan(&current); err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		if m.version <= current {
+			continue
+		}
+
+		err := s.withTx(ctx, func(w *sqliteWriter) error {
+			for _, t := range m.tables {
+				if _, err := w.tx.ExecContext(ctx, createTable(t, sqliteTypes)); err != nil {
+					return err
+				}
+			}
+
+			_, err := w.tx.ExecContext(ctx, "INSERT INTO schema_migrations (version, applied_at) VALUES (?, ?)", m.version, time.Now().UTC())
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sqliteWriter writes rows within a SQLite transaction.
+type sqliteWriter struct {
+	tx *sql.Tx
+}
+
+// withTx runs the given function in a transaction, committing if it
+// succeeds and rolling back otherwise.
+func (s *SQLite) withTx(ctx context.Context, fn func(w *sqliteWriter) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(&sqliteWriter{tx: tx}); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (w *sqliteWriter) upsert(ctx context.Context, table string, rows [][]any) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	cols := tableColumns(table)
+	query := "INSERT OR REPLACE INTO " + table + " (" + strings.Join(cols, ", ") +
+		") VALUES (" + strings.TrimSuffix(strings.Repeat("?, ", len(cols)), ", ") + ")"
+
+	stmt, err := w.tx.PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, row := range rows {
+		if _, err := stmt.ExecContext(ctx, row...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *sqliteWriter) deleteWhere(ctx context.Context, table string, cols []string, vals ...any) error {
+	conds := make([]string, 0, len(cols))
+	for _, c := range cols {
+		conds = append(conds, c+" = ?")
+	}
+
+	_, err := w.tx.ExecContext(ctx, "DELETE FROM "+table+" WHERE "+strings.Join(conds, " AND "), vals...)
+	return err
+}
+
+// SaveTeams
+func (s *SQLite) SaveTeams(ctx context.Context, teams []pll.Team) error {
+	return s.withTx(ctx, func(w *sqliteWriter) error {
+		return saveTeams(ctx, w, teams)
+	})
+}
+
+// SaveStandings saves the given regular season standings and their
+// teams for the given year.
+func (s *SQLite) SaveStandings(ctx context.Context, year int, standings []pll.Standing) error {
+	return s.withTx(ctx, func(w *sqliteWriter) error {
+		return saveStandings(ctx, w, year, standings)
+	})
+}
+
+// SaveTeamSeasons saves the given teams along with their stat lines
+// and coaches.
+func (s *SQLite) SaveTeamSeasons(ctx context.Context, seasons []pll.TeamSeason) error {
+	return s.withTx(ctx, func(w *sqliteWriter) error {
+		return saveTeamSeasons(ctx, w, seasons)
+	})
+}
+
+// SavePlayerSeasons saves the given players along with their stat
+// lines.
+func (s *SQLite) SavePlayerSeasons(ctx context.Context, seasons []pll.PlayerSeason) error {
+	return s.withTx(ctx, func(w *sqliteWriter) error {
+		return savePlayerSeasons(ctx, w, seasons)
+	})
+}
+
+// SavePlayerStatLeaders replaces the stat leaders for the given year
+// and season segment.
+func (s *SQLite) SavePlayerStatLeaders(ctx context.Context, year int, segment string, leaders []pll.PlayerStatLeader) error {
+	return s.withTx(ctx, func(w *sqliteWriter) error {
+		return savePlayerStatLeaders(ctx, w, year, segment, leaders)
+	})
+}
+
+// SaveGames saves the given games and their teams.
+func (s *SQLite) SaveGames(ctx context.Context, games []pll.Game) error {
+	return s.withTx(ctx, func(w *sqliteWriter) error {
+		return saveGames(ctx, w, games)
+	})
+}
+
+// SaveBoxScore replaces the team and player stat lines of the given
+// game.
+func (s *SQLite) SaveBoxScore(ctx context.Context, bs pll.BoxScore) error {
+	return s.withTx(ctx, func(w *sqliteWriter) error {
+		return saveBoxScore(ctx, w, bs)
+	})
+}
+
+// Games returns the stored games of the given year. Only the ID of
+// each team is populated.
+func (s *SQLite) Games(ctx context.Context, year int) ([]pll.Game, error) {
+	const query = `SELECT id, slug, year, week, start_time, segment, event_status, home_team_id, away_team_id, home_score, away_score
+FROM games WHERE year = ?`
+
+	rows, err := s.db.QueryContext(ctx, query, year)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var games []pll.Game
+	for rows.Next() {
+		var g pll.Game
+		var start time.Time
+		err := rows.Scan(&g.ID, &g.Slug, &g.Year, &g.Week, &start, &g.SeasonSegment, &g.EventStatus,
+			&g.HomeTeam.OfficialID, &g.AwayTeam.OfficialID, &g.HomeScore, &g.AwayScore)
+		if err != nil {
+			return nil, err
+		}
+		g.StartTime = pll.UnixTime(start.Unix())
+		games = append(games, g)
+	}
+
+	return games, rows.Err()
+}
+
+// RecordSync saves the given sync metadata.
+func (s *SQLite) RecordSync(ctx context.Context, rec SyncRecord) error {
+	return s.withTx(ctx, func(w *sqliteWriter) error {
+		return w.upsert(ctx, "sync_metadata", [][]any{syncRecordRow(rec)})
+	})
+}
+
+// LastSync returns the metadata of the last sync of the given table,
+// year and season segment or nil if it has never been synced.
+func (s *SQLite) LastSync(ctx context.Context, table string, year int, segment string) (*SyncRecord, error) {
+	const query = `SELECT table_name, year, segment, synced_at, row_count, complete
+FROM sync_metadata WHERE table_name = ? AND year = ? AND segment = ?`
+
+	var rec SyncRecord
+	row := s.db.QueryRowContext(ctx, query, table, year, segment)
+	err := row.Scan(&rec.Table, &rec.Year, &rec.Segment, &rec.SyncedAt, &rec.Rows, &rec.Complete)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &rec, nil
+}
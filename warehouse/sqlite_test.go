@@ -0,0 +1,412 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package warehouse
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/briandowns/pll/pll"
+)
+
+// openSQLite opens a new SQLite store in a temporary directory.
+func openSQLite(t *testing.T) (*SQLite, string) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "pll.db")
+	s, err := OpenSQLite(context.Background(), path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+
+	return s, path
+}
+
+// count returns the result of the given COUNT query.
+func count(t *testing.T, s *SQLite, query string, args ...any) int {
+	t.Helper()
+
+	var n int
+	if err := s.db.QueryRowContext(context.Background(), query, args...).Scan(&n); err != nil {
+		t.Fatal(err)
+	}
+
+	return n
+}
+
+func TestStatColumnsMatchStructs(t *testing.T) {
+	tests := []struct {
+		name string
+		cols []column
+		v    any
+	}{
+		{"player stats", playerStatsColumns, pll.PlayerStats{}},
+		{"team stats", teamStatsColumns, pll.TeamStats{}},
+	}
+	for _, tt := range tests {
+		typ := reflect.TypeOf(tt.v)
+		if len(tt.cols) != typ.NumField() || len(statValues(tt.v)) != len(tt.cols) {
+			t.Errorf("%s: %d columns for %d fields", tt.name, len(tt.cols), typ.NumField())
+			continue
+		}
+		for i, c := range tt.cols {
+			kind := reflect.TypeOf(statValues(tt.v)[i]).Kind()
+			if (c.kind == kindReal) != (kind == reflect.Float64) {
+				t.Errorf("%s: column %s has kind %d for %s field %s", tt.name, c.name, c.kind, kind, typ.Field(i).Name)
+			}
+		}
+	}
+
+	if got := snakeCase("twoPointShotsOnGoalPct"); got != "two_point_shots_on_goal_pct" {
+		t.Errorf("snakeCase = %q", got)
+	}
+	if got := snakeCase("pointsPG"); got != "points_pg" {
+		t.Errorf("snakeCase = %q", got)
+	}
+
+	rows := map[string][]any{
+		"teams":               teamRow(pll.Team{}),
+		"standings":           standingRow(0, pll.Standing{}),
+		"team_seasons":        teamSeasonRow(pll.TeamSeason{}),
+		"team_coaches":        coachRow("", 0, pll.Coach{}),
+		"players":             playerRow(pll.Player{}),
+		"player_seasons":      playerSeasonRow(pll.PlayerSeason{}),
+		"player_stat_leaders": leaderRow(0, "", pll.PlayerStatLeader{}),
+		"games":               gameRow(pll.Game{}),
+		"box_score_teams":     boxScoreTeamRow("", pll.BoxScoreTeam{}),
+		"box_score_players":   boxScorePlayerRow("", pll.BoxScorePlayer{}),
+		"sync_metadata":       syncRecordRow(SyncRecord{}),
+	}
+	for table, row := range rows {
+		if n := len(tableColumns(table)); n != len(row) {
+			t.Errorf("%s: %d columns, row has %d values", table, n, len(row))
+		}
+	}
+}
+
+func TestSQLiteMigrationsRerun(t *testing.T) {
+	ctx := context.Background()
+	s, path := openSQLite(t)
+
+	if err := s.SaveTeams(ctx, []pll.Team{{OfficialID: "ARC", FullName: "Archers"}}); err != nil {
+		t.Fatal(err)
+	}
+	s.Close()
+
+	reopened, err := OpenSQLite(ctx, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reopened.Close()
+
+	if n := count(t, reopened, "SELECT COUNT(*) FROM schema_migrations"); n != len(migrations) {
+		t.Errorf("%d migrations recorded, want %d", n, len(migrations))
+	}
+	if n := count(t, reopened, "SELECT COUNT(*) FROM teams"); n != 1 {
+		t.Errorf("%d teams after reopening, want 1", n)
+	}
+}
+
+func TestSQLiteUpsert(t *testing.T) {
+	ctx := context.Background()
+	s, _ := openSQLite(t)
+
+	archers := pll.Team{OfficialID: "ARC", FullName: "Archers"}
+	standing := pll.Standing{Team: archers, Seed: 2, Wins: 5}
+	if err := s.SaveStandings(ctx, 2024, []pll.Standing{standing}); err != nil {
+		t.Fatal(err)
+	}
+
+	archers.FullName = "Utah Archers"
+	standing.Team, standing.Seed, standing.Wins = archers, 1, 6
+	if err := s.SaveStandings(ctx, 2024, []pll.Standing{standing}); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := count(t, s, "SELECT COUNT(*) FROM standings"); n != 1 {
+		t.Errorf("%d standings, want 1", n)
+	}
+	if n := count(t, s, "SELECT wins FROM standings WHERE team_id = 'ARC' AND year = 2024"); n != 6 {
+		t.Errorf("wins = %d, want 6", n)
+	}
+	if n := count(t, s, "SELECT COUNT(*) FROM teams WHERE full_name = 'Utah Archers'"); n != 1 {
+		t.Error("team not replaced")
+	}
+
+	leaders := []pll.PlayerStatLeader{
+		{OfficialID: "P1", StatType: "points", StatValue: "30", PlayerRank: 1},
+		{OfficialID: "P2", StatType: "points", StatValue: "20", PlayerRank: 2},
+	}
+	if err := s.SavePlayerStatLeaders(ctx, 2024, "regular", leaders); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SavePlayerStatLeaders(ctx, 2024, "regular", leaders[1:]); err != nil {
+		t.Fatal(err)
+	}
+	if n := count(t, s, "SELECT COUNT(*) FROM player_stat_leaders WHERE year = 2024 AND segment = 'regular'"); n != 1 {
+		t.Errorf("%d leaders after replacing, want 1", n)
+	}
+
+	bs := pll.BoxScore{
+		Game: pll.Game{ID: "g1"},
+		Players: []pll.BoxScorePlayer{
+			{Player: pll.Player{OfficialID: "P1"}, TeamID: "ARC"},
+			{Player: pll.Player{OfficialID: "P2"}, TeamID: "ARC"},
+		},
+	}
+	if err := s.SaveBoxScore(ctx, bs); err != nil {
+		t.Fatal(err)
+	}
+	bs.Players = bs.Players[:1]
+	if err := s.SaveBoxScore(ctx, bs); err != nil {
+		t.Fatal(err)
+	}
+	if n := count(t, s, "SELECT COUNT(*) FROM box_score_players WHERE game_id = 'g1'"); n != 1 {
+		t.Errorf("%d box score players after replacing, want 1", n)
+	}
+}
+
+func TestSQLiteGamesAndLastSync(t *testing.T) {
+	ctx := context.Background()
+	s, _ := openSQLite(t)
+
+	games := []pll.Game{
+		{ID: "g1", Year: 2024, Week: 1, StartTime: 1717268400, SeasonSegment: "regular", EventStatus: 3,
+			HomeTeam: pll.Team{OfficialID: "ARC"}, AwayTeam: pll.Team{OfficialID: "WHP"}, HomeScore: 14, AwayScore: 11},
+		{ID: "g2", Year: 2024, Week: 2, SeasonSegment: "regular",
+			HomeTeam: pll.Team{OfficialID: "WHP"}, AwayTeam: pll.Team{OfficialID: "ARC"}},
+	}
+	if err := s.SaveGames(ctx, games); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := s.Games(ctx, 2024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slices.SortFunc(got, func(a, b pll.Game) int { return a.Week - b.Week })
+	if len(got) != 2 {
+		t.Fatalf("got %d games, want 2", len(got))
+	}
+	for i, g := range got {
+		want := games[i]
+		if g.ID != want.ID || g.StartTime != want.StartTime || g.EventStatus != want.EventStatus ||
+			g.HomeTeam.OfficialID != want.HomeTeam.OfficialID || g.HomeScore != want.HomeScore {
+			t.Errorf("game %d = %+v, want %+v", i, g, want)
+		}
+	}
+
+	rec, err := s.LastSync(ctx, "games", 2024, "")
+	if err != nil || rec != nil {
+		t.Fatalf("LastSync before syncing = %+v, %v", rec, err)
+	}
+
+	synced := time.Date(2024, 6, 2, 12, 0, 0, 0, time.UTC)
+	if err := s.RecordSync(ctx, SyncRecord{Table: "games", Year: 2024, SyncedAt: synced, Rows: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.RecordSync(ctx, SyncRecord{Table: "games", Year: 2024, SyncedAt: synced.Add(time.Hour), Rows: 2, Complete: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	rec, err = s.LastSync(ctx, "games", 2024, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := SyncRecord{Table: "games", Year: 2024, SyncedAt: synced.Add(time.Hour), Rows: 2, Complete: true}
+	if rec == nil || rec.Table != want.Table || rec.Rows != want.Rows || !rec.Complete || !rec.SyncedAt.Equal(want.SyncedAt) {
+		t.Errorf("LastSync = %+v, want %+v", rec, want)
+	}
+}
+
+// fakeClient serves a single season from memory and records the box
+// scores requested.
+type fakeClient struct {
+	games     []pll.Game
+	boxScores []string
+}
+
+func (c *fakeClient) Games(ctx context.Context, year int) (*pll.GamesResponse, error) {
+	return &pll.GamesResponse{SeasonEvents: slices.Clone(c.games)}, nil
+}
+
+func (c *fakeClient) BoxScore(ctx context.Context, gameID string) (*pll.BoxScoreResponse, error) {
+	c.boxScores = append(c.boxScores, gameID)
+	for _, g := range c.games {
+		if g.ID == gameID {
+			return &pll.BoxScoreResponse{Event: pll.BoxScore{Game: g}}, nil
+		}
+	}
+
+	return &pll.BoxScoreResponse{}, nil
+}
+
+func (c *fakeClient) Standings(ctx context.Context, year int, champSeries bool) (*pll.StandingsResponse, error) {
+	return &pll.StandingsResponse{}, nil
+}
+
+func (c *fakeClient) TeamSeasonStats(ctx context.Context, year int, seasonSegment string) (*pll.TeamSeasonStatsResponse, error) {
+	return &pll.TeamSeasonStatsResponse{}, nil
+}
+
+func (c *fakeClient) PlayerSeasonStats(ctx context.Context, year int, seasonSegment string) (*pll.PlayerSeasonStatsResponse, error) {
+	if seasonSegment != "regular" {
+		return &pll.PlayerSeasonStatsResponse{}, nil
+	}
+
+	return &pll.PlayerSeasonStatsResponse{AllPlayers: []pll.PlayerSeason{
+		{Player: pll.Player{OfficialID: "P1"}, Year: year, SeasonSegment: seasonSegment, Stats: pll.PlayerStats{GamesPlayed: 1, Points: 3}},
+	}}, nil
+}
+
+func (c *fakeClient) PlayerStats(ctx context.Context, year, limit int, seasonSegment string, stats []string) (*pll.PlayerStatsResponse, error) {
+	if seasonSegment != "regular" {
+		return &pll.PlayerStatsResponse{}, nil
+	}
+
+	return &pll.PlayerStatsResponse{PlayerStatLeaders: []pll.PlayerStatLeader{
+		{OfficialID: "P1", StatType: "points", StatValue: "3", PlayerRank: 1, Year: year},
+	}}, nil
+}
+
+func TestSyncDetectsChangedGames(t *testing.T) {
+	ctx := context.Background()
+	s, _ := openSQLite(t)
+
+	game := func(id string, status, home, away int) pll.Game {
+		return pll.Game{
+			ID: id, Year: 2024, SeasonSegment: "regular", EventStatus: status,
+			HomeTeam: pll.Team{OfficialID: "ARC"}, AwayTeam: pll.Team{OfficialID: "WHP"},
+			HomeScore: home, AwayScore: away,
+		}
+	}
+
+	client := &fakeClient{games: []pll.Game{
+		game("g1", 3, 10, 8),
+		game("g2", 3, 12, 11),
+		game("g3", 0, 0, 0),
+	}}
+	syncer := NewSyncer(client, s)
+	opts := SyncOptions{FromYear: 2024, ToYear: 2024}
+
+	steps := []struct {
+		name     string
+		update   func()
+		want     []string
+		complete bool
+	}{
+		{
+			name: "initial sync",
+			want: []string{"g1", "g2"},
+		},
+		{
+			name: "unchanged",
+		},
+		{
+			name: "score corrected and game completed",
+			update: func() {
+				client.games[1].AwayScore = 12
+				client.games[2] = game("g3", 3, 9, 7)
+			},
+			want:     []string{"g2", "g3"},
+			complete: true,
+		},
+		{
+			name: "complete season skipped",
+			update: func() {
+				client.games[0].HomeScore = 11
+			},
+			complete: true,
+		},
+	}
+
+	for _, step := range steps {
+		client.boxScores = nil
+		if step.update != nil {
+			step.update()
+		}
+
+		if err := syncer.Sync(ctx, opts); err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+
+		if !slices.Equal(client.boxScores, step.want) {
+			t.Errorf("%s: box scores retrieved %v, want %v", step.name, client.boxScores, step.want)
+		}
+
+		rec, err := s.LastSync(ctx, "games", 2024, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rec == nil || rec.Complete != step.complete {
+			t.Errorf("%s: games sync = %+v, want complete %t", step.name, rec, step.complete)
+		}
+	}
+
+	if n := count(t, s, "SELECT COUNT(*) FROM player_stat_leaders WHERE year = 2024"); n != 1 {
+		t.Errorf("%d stat leaders synced, want 1", n)
+	}
+
+	client.games = append(client.games, game("g4", 0, 0, 0))
+	opts.Full = true
+	client.boxScores = nil
+	if err := syncer.Sync(ctx, opts); err != nil {
+		t.Fatal(err)
+	}
+	if len(client.boxScores) != 3 {
+		t.Errorf("full sync retrieved box scores %v, want every final game", client.boxScores)
+	}
+}
+
+func TestSyncFailsWithoutLeaders(t *testing.T) {
+	s, _ := openSQLite(t)
+	client := &noLeadersClient{fakeClient{games: []pll.Game{{ID: "g1", Year: 2024, EventStatus: 3}}}}
+
+	err := NewSyncer(client, s).Sync(context.Background(), SyncOptions{FromYear: 2024, ToYear: 2024})
+	if err == nil {
+		t.Fatal("expected an error when no stat leaders are returned")
+	}
+
+	rec, err := s.LastSync(context.Background(), "player_stat_leaders", 2024, "regular")
+	if err != nil || rec != nil {
+		t.Errorf("stat leaders recorded as synced: %+v, %v", rec, err)
+	}
+}
+
+// noLeadersClient returns players but no stat leaders.
+type noLeadersClient struct {
+	fakeClient
+}
+
+func (c *noLeadersClient) PlayerStats(ctx context.Context, year, limit int, seasonSegment string, stats []string) (*pll.PlayerStatsResponse, error) {
+	return &pll.PlayerStatsResponse{}, nil
+}
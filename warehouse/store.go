@@ -0,0 +1,185 @@
+/*-
+ * SPDX-License-Identifier: BSD-2-Clause
+ *
+ * Copyright (c) 2025 Brian J. Downs
+ *
+ * Redistribution and use in source and binary forms, with or without
+ * modification, are permitted provided that the following conditions
+ * are met:
+ * 1. Redistributions of source code must retain the above copyright
+ *    notice, this list of conditions and the following disclaimer.
+ * 2. Redistributions in binary form must reproduce the above copyright
+ *    notice, this list of conditions and the following disclaimer in the
+ *    documentation and/or other materials provided with the distribution.
+ *
+ * THIS SOFTWARE IS PROVIDED BY THE REGENTS AND CONTRIBUTORS ``AS IS'' AND
+ * ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+ * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+ * ARE DISCLAIMED.  IN NO EVENT SHALL THE REGENTS OR CONTRIBUTORS BE LIABLE
+ * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+ * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS
+ * OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION)
+ * HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+ * LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY
+ * OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF
+ * SUCH DAMAGE.
+ */
+package warehouse
+
+import (
+	"context"
+
+	"github.com/briandowns/pll/pll"
+)
+
+// Store persists PLL data. Every implementation uses the same schema.
+type Store interface {
+	SaveTeams(ctx context.Context, teams []pll.Team) error
+	SaveStandings(ctx context.Context, year int, standings []pll.Standing) error
+	SaveTeamSeasons(ctx context.Context, seasons []pll.TeamSeason) error
+	SavePlayerSeasons(ctx context.Context, seasons []pll.PlayerSeason) error
+	SavePlayerStatLeaders(ctx context.Context, year int, segment string, leaders []pll.PlayerStatLeader) error
+	SaveGames(ctx context.Context, games []pll.Game) error
+	SaveBoxScore(ctx context.Context, bs pll.BoxScore) error
+	Games(ctx context.Context, year int) ([]pll.Game, error)
+	RecordSync(ctx context.Context, rec SyncRecord) error
+	LastSync(ctx context.Context, table string, year int, segment string) (*SyncRecord, error)
+	Close() error
+}
+
+// writer writes rows to the tables of a store within a transaction.
+type writer interface {
+	// upsert inserts the given rows into the given table, replacing
+	// any rows with the same primary key.
+	upsert(ctx context.Context, table string, rows [][]any) error
+
+	// deleteWhere deletes the rows of the given table whose columns
+	// equal the given values.
+	deleteWhere(ctx context.Context, table string, cols []string, vals ...any) error
+}
+
+// saveTeams
+func saveTeams(ctx context.Context, w writer, teams []pll.Team) error {
+	rows := make([][]any, 0, len(teams))
+	for _, t := range teams {
+		rows = append(rows, teamRow(t))
+	}
+
+	return w.upsert(ctx, "teams", rows)
+}
+
+// saveStandings
+func saveStandings(ctx context.Context, w writer, year int, standings []pll.Standing) error {
+	var teams []pll.Team
+	rows := make([][]any, 0, len(standings))
+	for _, st := range standings {
+		teams = append(teams, st.Team)
+		rows = append(rows, standingRow(year, st))
+	}
+
+	if err := saveTeams(ctx, w, teams); err != nil {
+		return err
+	}
+
+	return w.upsert(ctx, "standings", rows)
+}
+
+// saveTeamSeasons replaces the coaches of each team season.
+func saveTeamSeasons(ctx context.Context, w writer, seasons []pll.TeamSeason) error {
+	var teams []pll.Team
+	var rows, coaches [][]any
+	for _, ts := range seasons {
+		teams = append(teams, ts.Team)
+		rows = append(rows, teamSeasonRow(ts))
+		for _, c := range ts.Coaches {
+			coaches = append(coaches, coachRow(ts.OfficialID, ts.Year, c))
+		}
+
+		if err := w.deleteWhere(ctx, "team_coaches", []string{"team_id", "year"}, ts.OfficialID, ts.Year); err != nil {
+			return err
+		}
+	}
+
+	if err := saveTeams(ctx, w, teams); err != nil {
+		return err
+	}
+
+	if err := w.upsert(ctx, "team_seasons", rows); err != nil {
+		return err
+	}
+
+	return w.upsert(ctx, "team_coaches", coaches)
+}
+
+// savePlayerSeasons
+func savePlayerSeasons(ctx context.Context, w writer, seasons []pll.PlayerSeason) error {
+	var players, rows [][]any
+	for _, ps := range seasons {
+		players = append(players, playerRow(ps.Player))
+		rows = append(rows, playerSeasonRow(ps))
+	}
+
+	if err := w.upsert(ctx, "players", players); err != nil {
+		return err
+	}
+
+	return w.upsert(ctx, "player_seasons", rows)
+}
+
+// savePlayerStatLeaders replaces the leaders of the year and segment.
+func savePlayerStatLeaders(ctx context.Context, w writer, year int, segment string, leaders []pll.PlayerStatLeader) error {
+	rows := make([][]any, 0, len(leaders))
+	for _, l := range leaders {
+		rows = append(rows, leaderRow(year, segment, l))
+	}
+
+	if err := w.deleteWhere(ctx, "player_stat_leaders", []string{"year", "segment"}, year, segment); err != nil {
+		return err
+	}
+
+	return w.upsert(ctx, "player_stat_leaders", rows)
+}
+
+// saveGames
+func saveGames(ctx context.Context, w writer, games []pll.Game) error {
+	var teams []pll.Team
+	rows := make([][]any, 0, len(games))
+	for _, g := range games {
+		teams = append(teams, g.HomeTeam, g.AwayTeam)
+		rows = append(rows, gameRow(g))
+	}
+
+	if err := saveTeams(ctx, w, teams); err != nil {
+		return err
+	}
+
+	return w.upsert(ctx, "games", rows)
+}
+
+// saveBoxScore replaces the team and player stat lines of the game.
+func saveBoxScore(ctx context.Context, w writer, bs pll.BoxScore) error {
+	var teams, players [][]any
+	for _, t := range bs.Teams {
+		teams = append(teams, boxScoreTeamRow(bs.ID, t))
+	}
+	for _, p := range bs.Players {
+		players = append(players, boxScorePlayerRow(bs.ID, p))
+	}
+
+	for _, table := range []string{"box_score_teams", "box_score_players"} {
+		if err := w.deleteWhere(ctx, table, []string{"game_id"}, bs.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := w.upsert(ctx, "box_score_teams", teams); err != nil {
+		return err
+	}
+
+	return w.upsert(ctx, "box_score_players", players)
+}
+
+// syncRecordRow
+func syncRecordRow(rec SyncRecord) []any {
+	return []any{rec.Table, rec.Year, rec.Segment, rec.SyncedAt.UTC(), rec.Rows, rec.Complete}
+}